@@ -0,0 +1,18 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/geisonbiazus/blog/internal/app"
+	_ "github.com/joho/godotenv/autoload"
+)
+
+func main() {
+	c := app.NewContext()
+
+	if err := c.CLI(os.Stdout).Run(os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -10,11 +10,18 @@ import (
 func main() {
 	c := app.NewContext()
 
-	err := c.Migration().Up()
+	err := c.ValidatePosts()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = c.Migration().Up()
 	if err != nil {
 		log.Fatal(err)
 	}
 
 	c.Subscriptions().Start()
+	c.WatchContent()
+	c.SweepCache()
 	log.Fatal(c.WebServer().Start())
 }
@@ -0,0 +1,9 @@
+BEGIN;
+CREATE TABLE IF NOT EXISTS auth_revoked_tokens(
+   id VARCHAR PRIMARY KEY,
+   user_id uuid NOT NULL,
+   expires_at TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+   created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW(),
+   updated_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW()
+);
+END;
@@ -0,0 +1,4 @@
+BEGIN;
+DROP TABLE IF EXISTS discussion_comment_revisions;
+ALTER TABLE discussion_comments DROP COLUMN deleted_at;
+END;
@@ -0,0 +1,13 @@
+BEGIN;
+ALTER TABLE discussion_comments ADD COLUMN deleted_at TIMESTAMP WITHOUT TIME ZONE;
+UPDATE discussion_comments SET updated_at = created_at WHERE created_at IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS discussion_comment_revisions(
+   id uuid PRIMARY KEY,
+   comment_id uuid NOT NULL REFERENCES discussion_comments(id) ON DELETE CASCADE,
+   markdown text,
+   html text,
+   created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW(),
+   updated_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW()
+);
+END;
@@ -0,0 +1,3 @@
+BEGIN;
+ALTER TABLE auth_users DROP COLUMN role;
+END;
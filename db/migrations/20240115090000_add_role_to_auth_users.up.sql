@@ -0,0 +1,3 @@
+BEGIN;
+ALTER TABLE auth_users ADD COLUMN role VARCHAR NOT NULL DEFAULT 'commenter';
+END;
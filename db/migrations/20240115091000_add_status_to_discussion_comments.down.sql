@@ -0,0 +1,4 @@
+BEGIN;
+DROP INDEX IF EXISTS discussion_comments_status_idx;
+ALTER TABLE discussion_comments DROP COLUMN status;
+END;
@@ -0,0 +1,4 @@
+BEGIN;
+ALTER TABLE discussion_comments ADD COLUMN status VARCHAR NOT NULL DEFAULT 'approved';
+CREATE INDEX IF NOT EXISTS discussion_comments_status_idx ON discussion_comments(status);
+END;
@@ -0,0 +1,3 @@
+BEGIN;
+ALTER TABLE discussion_authors DROP COLUMN banned;
+END;
@@ -0,0 +1,3 @@
+BEGIN;
+ALTER TABLE discussion_authors ADD COLUMN banned BOOLEAN NOT NULL DEFAULT FALSE;
+END;
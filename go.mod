@@ -12,6 +12,7 @@ require (
 	github.com/yuin/goldmark v1.3.5
 	github.com/yuin/goldmark-highlighting v0.0.0-20210428103930-3a9678dbb86c
 	golang.org/x/oauth2 v0.0.0-20211104180415-d3ed0bb246c8
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -22,7 +23,6 @@ require (
 	github.com/stretchr/objx v0.5.0 // indirect
 	github.com/stretchr/testify v1.8.2-0.20221102114659-1333b5d3bda8 // indirect
 	go.uber.org/atomic v1.7.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
 
 require (
@@ -0,0 +1,10 @@
+package authorizer
+
+import (
+	"github.com/geisonbiazus/blog/internal/adapters/authorizer/role"
+	"github.com/geisonbiazus/blog/internal/core/auth"
+)
+
+func NewRoleAuthorizer(userRepo auth.UserRepo) *role.Authorizer {
+	return role.NewAuthorizer(userRepo)
+}
@@ -0,0 +1,37 @@
+package role
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/geisonbiazus/blog/internal/core/auth"
+)
+
+// Authorizer grants the permissions of the current role of the users, read
+// from the user repo so role changes apply right away.
+type Authorizer struct {
+	userRepo auth.UserRepo
+}
+
+func NewAuthorizer(userRepo auth.UserRepo) *Authorizer {
+	return &Authorizer{userRepo: userRepo}
+}
+
+func (a *Authorizer) CanModerateComments(ctx context.Context, userID string) (bool, error) {
+	return a.can(ctx, userID, auth.PermissionModerateComments)
+}
+
+func (a *Authorizer) can(ctx context.Context, userID string, permission auth.Permission) (bool, error) {
+	user, err := a.userRepo.FindUserByID(ctx, userID)
+
+	if errors.Is(err, auth.ErrUserNotFound) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("error on Authorizer when finding user: %w", err)
+	}
+
+	return user.Can(permission), nil
+}
@@ -0,0 +1,41 @@
+package role_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/geisonbiazus/blog/internal/adapters/authorizer/role"
+	"github.com/geisonbiazus/blog/internal/adapters/userrepo/memory"
+	"github.com/geisonbiazus/blog/internal/core/auth"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthorizer(t *testing.T) {
+	ctx := context.Background()
+	userRepo := memory.NewUserRepo()
+	authorizer := role.NewAuthorizer(userRepo)
+
+	userRepo.CreateUser(ctx, auth.User{ID: "ADMIN_ID", Role: auth.RoleAdmin})
+	userRepo.CreateUser(ctx, auth.User{ID: "MODERATOR_ID", Role: auth.RoleModerator})
+	userRepo.CreateUser(ctx, auth.User{ID: "COMMENTER_ID", Role: auth.RoleCommenter})
+
+	t.Run("CanModerateComments", func(t *testing.T) {
+		t.Run("It is true for the roles granting the permission", func(t *testing.T) {
+			for _, userID := range []string{"ADMIN_ID", "MODERATOR_ID"} {
+				canModerate, err := authorizer.CanModerateComments(ctx, userID)
+
+				assert.True(t, canModerate, userID)
+				assert.Nil(t, err)
+			}
+		})
+
+		t.Run("It is false for other roles and unknown users", func(t *testing.T) {
+			for _, userID := range []string{"COMMENTER_ID", "UNKNOWN_ID", ""} {
+				canModerate, err := authorizer.CanModerateComments(ctx, userID)
+
+				assert.False(t, canModerate, userID)
+				assert.Nil(t, err)
+			}
+		})
+	})
+}
@@ -1,14 +1,37 @@
 package cache
 
 import (
+	"time"
+
 	"github.com/geisonbiazus/blog/internal/adapters/cache/memory"
 	"github.com/geisonbiazus/blog/internal/adapters/cache/null"
+	"github.com/geisonbiazus/blog/internal/core/shared"
 )
 
+// ClearableCache is a cache that can be emptied when the cached content
+// changes, e.g. when a post file is edited.
+type ClearableCache interface {
+	shared.Cache
+	Clear()
+}
+
+// SweepableCache removes the expired values in the background instead of
+// waiting for them to be requested again.
+type SweepableCache interface {
+	StartSweeper(interval time.Duration)
+	Stop()
+}
+
 func NewMemoryCache() *memory.Cache {
 	return memory.NewCache()
 }
 
+// NewBoundedMemoryCache keeps up to maxEntries values, evicting the least
+// recently used ones. Zero means no limit.
+func NewBoundedMemoryCache(maxEntries int) *memory.Cache {
+	return memory.NewBoundedCache(maxEntries)
+}
+
 func NewNullCache() *null.Cache {
 	return null.NewCache()
 }
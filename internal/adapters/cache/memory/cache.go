@@ -1,18 +1,53 @@
 package memory
 
 import (
+	"container/list"
+	"errors"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/geisonbiazus/blog/internal/core/shared"
 )
 
+// Cache keeps the values in memory and is safe for concurrent use. Concurrent
+// calls missing the same key wait for a single resolution. When bounded, the
+// least recently used values are evicted to make room for new ones.
 type Cache struct {
-	storage map[string]cachedItem
+	maxEntries int
+	entries    map[string]*list.Element
+	recency    *list.List
+	calls      map[string]*call
+	generation uint64
+	mutex      sync.Mutex
+	hits       atomic.Uint64
+	misses     atomic.Uint64
+	stop       chan struct{}
+	stopOnce   sync.Once
 }
 
+// Stats counts how the calls to Do were served. Calls waiting for a resolution
+// already in flight count as hits as they don't run their resolve fn.
+type Stats struct {
+	Hits    uint64
+	Misses  uint64
+	Entries int
+}
+
+var ErrResolvePanicked = errors.New("cache resolve fn panicked")
+
 func NewCache() *Cache {
+	return NewBoundedCache(0)
+}
+
+// NewBoundedCache keeps up to maxEntries values. Zero means no limit.
+func NewBoundedCache(maxEntries int) *Cache {
 	return &Cache{
-		storage: map[string]cachedItem{},
+		maxEntries: maxEntries,
+		entries:    map[string]*list.Element{},
+		recency:    list.New(),
+		calls:      map[string]*call{},
+		stop:       make(chan struct{}),
 	}
 }
 
@@ -21,49 +56,204 @@ func (c *Cache) Do(
 	resolve shared.ResolveFn,
 	expiresIn time.Duration,
 ) (interface{}, error) {
-	item, ok := c.storage[key]
-	if !ok || item.isExpired() {
-		return c.resolveAndStoreValue(key, resolve, expiresIn)
+	c.mutex.Lock()
+
+	if value, ok := c.get(key); ok {
+		c.mutex.Unlock()
+		c.hits.Add(1)
+		return value, nil
+	}
+
+	if inFlight, ok := c.calls[key]; ok {
+		c.mutex.Unlock()
+		c.hits.Add(1)
+		return inFlight.wait()
+	}
+
+	newCall := &call{done: make(chan struct{})}
+	c.calls[key] = newCall
+	generation := c.generation
+	c.mutex.Unlock()
+
+	c.misses.Add(1)
+	c.resolve(key, newCall, generation, resolve, expiresIn)
+
+	return newCall.value, newCall.err
+}
+
+// Clear removes every cached value so they are resolved again on the next
+// call. Values being resolved at the moment are not stored, as they may have
+// been resolved from the content before the change.
+func (c *Cache) Clear() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.entries = map[string]*list.Element{}
+	c.recency.Init()
+	c.calls = map[string]*call{}
+	c.generation++
+}
+
+// Stats returns the counters since the cache was created.
+func (c *Cache) Stats() Stats {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return Stats{
+		Hits:    c.hits.Load(),
+		Misses:  c.misses.Load(),
+		Entries: len(c.entries),
+	}
+}
+
+// StartSweeper removes the expired values on every interval in a new
+// goroutine until Stop is called, so values no longer requested don't hold
+// memory.
+func (c *Cache) StartSweeper(interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-c.stop:
+				return
+			case <-ticker.C:
+				c.Sweep()
+			}
+		}
+	}()
+}
+
+func (c *Cache) Stop() {
+	c.stopOnce.Do(func() { close(c.stop) })
+}
+
+// Sweep removes the expired values.
+func (c *Cache) Sweep() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	now := time.Now()
+
+	for _, element := range c.entries {
+		if element.Value.(*cachedItem).isExpired(now) {
+			c.remove(element)
+		}
 	}
-	return item.value, nil
 }
 
-func (c *Cache) resolveAndStoreValue(
+// get must be called with the mutex locked.
+func (c *Cache) get(key string) (interface{}, bool) {
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	item := element.Value.(*cachedItem)
+
+	if item.isExpired(time.Now()) {
+		c.remove(element)
+		return nil, false
+	}
+
+	c.recency.MoveToFront(element)
+	return item.value, true
+}
+
+// resolve releases the waiting calls even when the resolve fn panics, which
+// then goes on up the stack of the caller that ran it.
+func (c *Cache) resolve(
 	key string,
+	call *call,
+	generation uint64,
 	resolve shared.ResolveFn,
 	expiresIn time.Duration,
-) (interface{}, error) {
-	value, err := resolve()
+) {
+	resolved := false
+
+	defer func() {
+		if !resolved {
+			call.err = ErrResolvePanicked
+		}
 
-	if err == nil {
-		c.storage[key] = newCachedItem(value, expiresIn)
+		c.finish(key, call, generation, expiresIn)
+	}()
+
+	call.value, call.err = resolve()
+	resolved = true
+}
+
+func (c *Cache) finish(key string, call *call, generation uint64, expiresIn time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.calls[key] == call {
+		delete(c.calls, key)
 	}
 
-	return value, err
+	if call.err == nil && generation == c.generation {
+		c.store(key, call.value, expiresIn)
+	}
+
+	close(call.done)
+}
+
+// store must be called with the mutex locked.
+func (c *Cache) store(key string, value interface{}, expiresIn time.Duration) {
+	if element, ok := c.entries[key]; ok {
+		c.remove(element)
+	}
+
+	c.entries[key] = c.recency.PushFront(newCachedItem(key, value, expiresIn))
+
+	for c.maxEntries > 0 && c.recency.Len() > c.maxEntries {
+		c.remove(c.recency.Back())
+	}
+}
+
+// remove must be called with the mutex locked.
+func (c *Cache) remove(element *list.Element) {
+	c.recency.Remove(element)
+	delete(c.entries, element.Value.(*cachedItem).key)
+}
+
+// call is a resolution in flight, which other calls for the same key wait for.
+type call struct {
+	done  chan struct{}
+	value interface{}
+	err   error
+}
+
+func (c *call) wait() (interface{}, error) {
+	<-c.done
+	return c.value, c.err
 }
 
 type cachedItem struct {
+	key       string
 	value     interface{}
 	createdAt time.Time
 	expiresIn time.Duration
 }
 
-func newCachedItem(value interface{}, expiresIn time.Duration) cachedItem {
-	return cachedItem{
+func newCachedItem(key string, value interface{}, expiresIn time.Duration) *cachedItem {
+	return &cachedItem{
+		key:       key,
 		value:     value,
 		createdAt: time.Now(),
 		expiresIn: expiresIn,
 	}
 }
 
-func (i cachedItem) isExpired() bool {
+func (i *cachedItem) isExpired(now time.Time) bool {
 	if i.expiresIn == shared.NeverExpire {
 		return false
 	}
 
-	return i.expiresAt().Before(time.Now())
+	return i.expiresAt().Before(now)
 }
 
-func (i cachedItem) expiresAt() time.Time {
+func (i *cachedItem) expiresAt() time.Time {
 	return i.createdAt.Add(i.expiresIn)
 }
@@ -2,6 +2,8 @@ package memory_test
 
 import (
 	"errors"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -119,5 +121,277 @@ func TestCache(t *testing.T) {
 
 			assert.Equal(t, 2, calls)
 		})
+
+		t.Run("It resolves the value once for concurrent calls with the same key", func(t *testing.T) {
+			cache := memory.NewCache()
+			var calls int32
+			release := make(chan struct{})
+
+			resolve := func() (interface{}, error) {
+				atomic.AddInt32(&calls, 1)
+				<-release
+				return "value", nil
+			}
+
+			results := doConcurrently(10, func() (interface{}, error) {
+				return cache.Do("key", resolve, shared.NeverExpire)
+			}, func() {
+				waitForStats(t, cache, 1, 9)
+				close(release)
+			})
+
+			for _, result := range results {
+				assert.Equal(t, "value", result)
+			}
+			assert.Equal(t, int32(1), calls)
+		})
+
+		t.Run("It shares the error with the concurrent calls without caching it", func(t *testing.T) {
+			cache := memory.NewCache()
+			err := errors.New("error")
+			var calls int32
+			release := make(chan struct{})
+
+			resolve := func() (interface{}, error) {
+				atomic.AddInt32(&calls, 1)
+				<-release
+				return nil, err
+			}
+
+			var errs []error
+			var mutex sync.Mutex
+
+			doConcurrently(5, func() (interface{}, error) {
+				_, returnedErr := cache.Do("key", resolve, shared.NeverExpire)
+				mutex.Lock()
+				errs = append(errs, returnedErr)
+				mutex.Unlock()
+				return nil, nil
+			}, func() {
+				waitForStats(t, cache, 1, 4)
+				close(release)
+			})
+
+			for _, returnedErr := range errs {
+				assert.Equal(t, err, returnedErr)
+			}
+			assert.Equal(t, int32(1), calls)
+			assert.Equal(t, 0, cache.Stats().Entries)
+		})
+
+		t.Run("It releases the concurrent calls when the resolve fn panics", func(t *testing.T) {
+			cache := memory.NewCache()
+			release := make(chan struct{})
+			waiterErr := make(chan error)
+
+			go func() {
+				defer func() { recover() }()
+
+				cache.Do("key", func() (interface{}, error) {
+					<-release
+					panic("resolve failed")
+				}, shared.NeverExpire)
+			}()
+
+			waitForStats(t, cache, 1, 0)
+
+			go func() {
+				_, err := cache.Do("key", func() (interface{}, error) {
+					return "value", nil
+				}, shared.NeverExpire)
+				waiterErr <- err
+			}()
+
+			waitForStats(t, cache, 1, 1)
+			close(release)
+
+			assert.Equal(t, memory.ErrResolvePanicked, <-waiterErr)
+
+			result, err := cache.Do("key", func() (interface{}, error) {
+				return "value", nil
+			}, shared.NeverExpire)
+
+			assert.Equal(t, "value", result)
+			assert.Nil(t, err)
+		})
+
+		t.Run("It resolves different keys concurrently", func(t *testing.T) {
+			cache := memory.NewCache()
+			release := make(chan struct{})
+			done := make(chan struct{})
+
+			go func() {
+				cache.Do("blocked", func() (interface{}, error) {
+					<-release
+					return "blocked", nil
+				}, shared.NeverExpire)
+				close(done)
+			}()
+
+			waitForStats(t, cache, 1, 0)
+
+			result, _ := cache.Do("key", func() (interface{}, error) {
+				return "value", nil
+			}, shared.NeverExpire)
+
+			assert.Equal(t, "value", result)
+
+			close(release)
+			<-done
+		})
 	})
+
+	t.Run("Bounds", func(t *testing.T) {
+		t.Run("It evicts the least recently used value when full", func(t *testing.T) {
+			cache := memory.NewBoundedCache(2)
+			calls := map[string]int{}
+
+			resolve := func(key string) shared.ResolveFn {
+				return func() (interface{}, error) {
+					calls[key]++
+					return key, nil
+				}
+			}
+
+			cache.Do("key1", resolve("key1"), shared.NeverExpire)
+			cache.Do("key2", resolve("key2"), shared.NeverExpire)
+			cache.Do("key1", resolve("key1"), shared.NeverExpire)
+			cache.Do("key3", resolve("key3"), shared.NeverExpire)
+
+			cache.Do("key1", resolve("key1"), shared.NeverExpire)
+			cache.Do("key2", resolve("key2"), shared.NeverExpire)
+
+			assert.Equal(t, 1, calls["key1"])
+			assert.Equal(t, 2, calls["key2"])
+			assert.Equal(t, 2, cache.Stats().Entries)
+		})
+
+		t.Run("It keeps any number of values when unbounded", func(t *testing.T) {
+			cache := memory.NewCache()
+
+			for _, key := range []string{"key1", "key2", "key3"} {
+				cache.Do(key, func() (interface{}, error) {
+					return key, nil
+				}, shared.NeverExpire)
+			}
+
+			assert.Equal(t, 3, cache.Stats().Entries)
+		})
+	})
+
+	t.Run("Sweep", func(t *testing.T) {
+		t.Run("It removes the expired values only", func(t *testing.T) {
+			cache := memory.NewCache()
+			resolve := func() (interface{}, error) { return "value", nil }
+
+			cache.Do("expired", resolve, -1*time.Minute)
+			cache.Do("valid", resolve, time.Hour)
+			cache.Do("permanent", resolve, shared.NeverExpire)
+
+			cache.Sweep()
+
+			assert.Equal(t, 2, cache.Stats().Entries)
+		})
+
+		t.Run("It sweeps in the background until stopped", func(t *testing.T) {
+			cache := memory.NewCache()
+			cache.Do("expired", func() (interface{}, error) {
+				return "value", nil
+			}, -1*time.Minute)
+
+			cache.StartSweeper(time.Millisecond)
+			defer cache.Stop()
+
+			assert.Eventually(t, func() bool {
+				return cache.Stats().Entries == 0
+			}, time.Second, time.Millisecond)
+		})
+	})
+
+	t.Run("Stats", func(t *testing.T) {
+		t.Run("It counts the hits and misses", func(t *testing.T) {
+			cache := memory.NewCache()
+			resolve := func() (interface{}, error) { return "value", nil }
+
+			cache.Do("key1", resolve, shared.NeverExpire)
+			cache.Do("key1", resolve, shared.NeverExpire)
+			cache.Do("key1", resolve, shared.NeverExpire)
+			cache.Do("key2", resolve, shared.NeverExpire)
+
+			assert.Equal(t, memory.Stats{Hits: 2, Misses: 2, Entries: 2}, cache.Stats())
+		})
+	})
+
+	t.Run("Clear", func(t *testing.T) {
+		t.Run("It resolves the values again after clearing", func(t *testing.T) {
+			cache := memory.NewCache()
+			calls := 0
+
+			resolve := func() (interface{}, error) {
+				calls++
+				return calls, nil
+			}
+
+			cache.Do("key", resolve, shared.NeverExpire)
+			cache.Clear()
+			result, _ := cache.Do("key", resolve, shared.NeverExpire)
+
+			assert.Equal(t, 2, result)
+			assert.Equal(t, 2, calls)
+		})
+
+		t.Run("It doesn't store a value resolved before clearing", func(t *testing.T) {
+			cache := memory.NewCache()
+			release := make(chan struct{})
+			done := make(chan struct{})
+
+			go func() {
+				cache.Do("key", func() (interface{}, error) {
+					<-release
+					return "stale", nil
+				}, shared.NeverExpire)
+				close(done)
+			}()
+
+			waitForStats(t, cache, 1, 0)
+			cache.Clear()
+			close(release)
+			<-done
+
+			result, _ := cache.Do("key", func() (interface{}, error) {
+				return "fresh", nil
+			}, shared.NeverExpire)
+
+			assert.Equal(t, "fresh", result)
+		})
+	})
+}
+
+// doConcurrently runs fn in n goroutines, calls whenStarted and waits for them
+// to finish, returning their values.
+func doConcurrently(n int, fn func() (interface{}, error), whenStarted func()) []interface{} {
+	results := make([]interface{}, n)
+	var wg sync.WaitGroup
+
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], _ = fn()
+		}(i)
+	}
+
+	whenStarted()
+	wg.Wait()
+
+	return results
+}
+
+// waitForStats waits until the calls to Do were counted, which means they are
+// either resolving or waiting for a resolution.
+func waitForStats(t *testing.T, cache *memory.Cache, misses, hits uint64) {
+	assert.Eventually(t, func() bool {
+		stats := cache.Stats()
+		return stats.Misses == misses && stats.Hits == hits
+	}, time.Second, time.Millisecond)
 }
@@ -19,3 +19,6 @@ func (c *Cache) Do(
 ) (interface{}, error) {
 	return resolve()
 }
+
+// Clear does nothing as no value is ever cached.
+func (c *Cache) Clear() {}
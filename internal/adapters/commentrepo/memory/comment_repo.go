@@ -8,14 +8,16 @@ import (
 )
 
 type CommentRepo struct {
-	comments map[string]*discussion.Comment
-	authors  map[string]*discussion.Author
+	comments  map[string]*discussion.Comment
+	authors   map[string]*discussion.Author
+	revisions []*discussion.CommentRevision
 }
 
 func NewCommentRepo() *CommentRepo {
 	return &CommentRepo{
-		comments: make(map[string]*discussion.Comment),
-		authors:  make(map[string]*discussion.Author),
+		comments:  make(map[string]*discussion.Comment),
+		authors:   make(map[string]*discussion.Author),
+		revisions: []*discussion.CommentRevision{},
 	}
 }
 
@@ -24,6 +26,52 @@ func (r *CommentRepo) SaveComment(ctx context.Context, comment *discussion.Comme
 	return nil
 }
 
+func (r *CommentRepo) UpdateComment(ctx context.Context, comment *discussion.Comment) error {
+	r.comments[comment.ID] = comment.Clone()
+	return nil
+}
+
+func (r *CommentRepo) DeleteComment(ctx context.Context, id string) error {
+	delete(r.comments, id)
+	return nil
+}
+
+func (r *CommentRepo) GetCommentByID(ctx context.Context, id string) (*discussion.Comment, error) {
+	comment, ok := r.comments[id]
+	if !ok {
+		return nil, nil
+	}
+
+	return comment.Clone(), nil
+}
+
+func (r *CommentRepo) HasReplies(ctx context.Context, id string) (bool, error) {
+	for _, comment := range r.comments {
+		if comment.SubjectID == id {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (r *CommentRepo) SaveCommentRevision(ctx context.Context, revision *discussion.CommentRevision) error {
+	r.revisions = append(r.revisions, revision)
+	return nil
+}
+
+func (r *CommentRepo) GetCommentRevisions(ctx context.Context, commentID string) ([]*discussion.CommentRevision, error) {
+	result := []*discussion.CommentRevision{}
+
+	for _, revision := range r.revisions {
+		if revision.CommentID == commentID {
+			result = append(result, revision)
+		}
+	}
+
+	return result, nil
+}
+
 func (r *CommentRepo) SaveAuthor(ctx context.Context, author *discussion.Author) error {
 	r.authors[author.ID] = author
 	return nil
@@ -43,16 +91,20 @@ func (r *CommentRepo) GetAuthorByUserID(ctx context.Context, userID string) (*di
 	return nil, nil
 }
 
-func (r *CommentRepo) GetCommentsAndRepliesRecursively(ctx context.Context, subjectID string) ([]*discussion.Comment, error) {
+func (r *CommentRepo) GetCommentsAndRepliesRecursively(ctx context.Context, subjectID, viewerUserID string) ([]*discussion.Comment, error) {
+	return discussion.HideComments(r.getThread(ctx, subjectID), func(comment *discussion.Comment) bool {
+		return r.isVisible(comment, viewerUserID)
+	}), nil
+}
+
+func (r *CommentRepo) getThread(ctx context.Context, subjectID string) []*discussion.Comment {
 	result := []*discussion.Comment{}
 
 	for _, comment := range r.comments {
 		if comment.SubjectID == subjectID {
 			clone := comment.Clone()
-			author, _ := r.GetAuthorByID(ctx, clone.AuthorID)
-			clone.Author = author
-			replies, _ := r.GetCommentsAndRepliesRecursively(ctx, comment.ID)
-			clone.Replies = replies
+			clone.Author, _ = r.GetAuthorByID(ctx, clone.AuthorID)
+			clone.Replies = r.getThread(ctx, comment.ID)
 
 			result = append(result, clone)
 		}
@@ -60,9 +112,103 @@ func (r *CommentRepo) GetCommentsAndRepliesRecursively(ctx context.Context, subj
 
 	sort.Sort(byCreatedAt(result))
 
+	return result
+}
+
+func (r *CommentRepo) isVisible(comment *discussion.Comment, viewerUserID string) bool {
+	if comment.Author != nil && comment.Author.Banned {
+		return false
+	}
+
+	if comment.Status == discussion.CommentApproved {
+		return true
+	}
+
+	return comment.IsPending() && viewerUserID != "" && comment.Author != nil && comment.Author.UserID == viewerUserID
+}
+
+func (r *CommentRepo) GetCommentsByStatus(ctx context.Context, status discussion.CommentStatus) ([]*discussion.Comment, error) {
+	result := []*discussion.Comment{}
+
+	for _, comment := range r.comments {
+		if comment.Status == status {
+			clone := comment.Clone()
+			clone.Author, _ = r.GetAuthorByID(ctx, clone.AuthorID)
+			clone.Replies = []*discussion.Comment{}
+			result = append(result, clone)
+		}
+	}
+
+	sort.Sort(byCreatedAt(result))
+
 	return result, nil
 }
 
+func (r *CommentRepo) GetRecentComments(ctx context.Context, subjectID string, limit int) ([]*discussion.Comment, error) {
+	result := []*discussion.Comment{}
+
+	for _, comment := range r.comments {
+		rootSubjectID, visible := r.resolveRootSubjectID(comment)
+
+		if !visible || comment.IsDeleted() || (subjectID != "" && rootSubjectID != subjectID) {
+			continue
+		}
+
+		clone := comment.Clone()
+		clone.Author, _ = r.GetAuthorByID(ctx, clone.AuthorID)
+		clone.Replies = []*discussion.Comment{}
+		clone.RootSubjectID = rootSubjectID
+		result = append(result, clone)
+	}
+
+	sort.Sort(sort.Reverse(byCreatedAt(result)))
+
+	return result[:min(limit, len(result))], nil
+}
+
+// resolveRootSubjectID walks up the comments being replied to until the subject
+// of the thread. Like on the thread itself, the comment is hidden when it is not
+// approved or written by a banned author, regardless of the ones above it.
+func (r *CommentRepo) resolveRootSubjectID(comment *discussion.Comment) (string, bool) {
+	author := r.authors[comment.AuthorID]
+
+	if comment.Status != discussion.CommentApproved || (author != nil && author.Banned) {
+		return "", false
+	}
+
+	for {
+		parent, ok := r.comments[comment.SubjectID]
+		if !ok {
+			return comment.SubjectID, true
+		}
+
+		comment = parent
+	}
+}
+
+func (r *CommentRepo) UpdateCommentStatus(ctx context.Context, id string, status discussion.CommentStatus) error {
+	comment, ok := r.comments[id]
+	if !ok {
+		return discussion.ErrCommentNotFound
+	}
+
+	clone := comment.Clone()
+	clone.Status = status
+	r.comments[id] = clone
+
+	return nil
+}
+
+func (r *CommentRepo) HasApprovedComments(ctx context.Context, authorID string) (bool, error) {
+	for _, comment := range r.comments {
+		if comment.AuthorID == authorID && comment.Status == discussion.CommentApproved {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 type byCreatedAt []*discussion.Comment
 
 func (c byCreatedAt) Len() int           { return len(c) }
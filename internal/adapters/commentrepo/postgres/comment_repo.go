@@ -5,9 +5,11 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/geisonbiazus/blog/internal/core/discussion"
 	"github.com/geisonbiazus/blog/pkg/dbrepo"
+	"github.com/google/uuid"
 )
 
 type CommentRepo struct {
@@ -32,6 +34,7 @@ func (r *CommentRepo) insertAuthor(ctx context.Context, author *discussion.Autho
 		"auth_user_id": author.UserID,
 		"name":         author.Name,
 		"avatar_url":   author.AvatarURL,
+		"banned":       author.Banned,
 	})
 
 	if err != nil {
@@ -48,6 +51,7 @@ func (r *CommentRepo) updateAuthor(ctx context.Context, author *discussion.Autho
 		"auth_user_id": author.UserID,
 		"name":         author.Name,
 		"avatar_url":   author.AvatarURL,
+		"banned":       author.Banned,
 	})
 
 	if err != nil {
@@ -62,7 +66,7 @@ func (r *CommentRepo) GetAuthorByID(ctx context.Context, id string) (*discussion
 
 	row := conn.QueryRowContext(ctx, `
 		SELECT 
-		id, auth_user_id, name, avatar_url 
+		id, auth_user_id, name, avatar_url, banned
 		FROM discussion_authors 
 		WHERE id = $1`,
 		id,
@@ -70,7 +74,7 @@ func (r *CommentRepo) GetAuthorByID(ctx context.Context, id string) (*discussion
 
 	author := &discussion.Author{Persisted: true}
 
-	err := row.Scan(&author.ID, &author.UserID, &author.Name, &author.AvatarURL)
+	err := row.Scan(&author.ID, &author.UserID, &author.Name, &author.AvatarURL, &author.Banned)
 
 	if errors.Is(err, sql.ErrNoRows) {
 		return nil, nil
@@ -88,7 +92,7 @@ func (r *CommentRepo) GetAuthorByUserID(ctx context.Context, userID string) (*di
 
 	row := conn.QueryRowContext(ctx, `
 		SELECT 
-			id, auth_user_id, name, avatar_url 
+			id, auth_user_id, name, avatar_url, banned
 		FROM discussion_authors 
 		WHERE auth_user_id = $1`,
 		userID,
@@ -96,7 +100,7 @@ func (r *CommentRepo) GetAuthorByUserID(ctx context.Context, userID string) (*di
 
 	author := &discussion.Author{Persisted: true}
 
-	err := row.Scan(&author.ID, &author.UserID, &author.Name, &author.AvatarURL)
+	err := row.Scan(&author.ID, &author.UserID, &author.Name, &author.AvatarURL, &author.Banned)
 
 	if errors.Is(err, sql.ErrNoRows) {
 		return nil, nil
@@ -116,7 +120,9 @@ func (r *CommentRepo) SaveComment(ctx context.Context, comment *discussion.Comme
 		"author_id":  comment.AuthorID,
 		"markdown":   comment.Markdown,
 		"html":       comment.HTML,
+		"status":     comment.Status,
 		"created_at": comment.CreatedAt,
+		"updated_at": comment.UpdatedAt,
 	})
 
 	if err != nil {
@@ -126,6 +132,316 @@ func (r *CommentRepo) SaveComment(ctx context.Context, comment *discussion.Comme
 	return nil
 }
 
-func (r *CommentRepo) GetCommentsAndRepliesRecursively(ctx context.Context, subjectID string) ([]*discussion.Comment, error) {
-	return newGetCommentsAndRepliesRecursivelyQuery(r.Conn(ctx), ctx, subjectID).run()
+func (r *CommentRepo) UpdateComment(ctx context.Context, comment *discussion.Comment) error {
+	rows, err := r.Exec(ctx, `
+		UPDATE discussion_comments
+		SET markdown = $2, html = $3, updated_at = $4, deleted_at = $5
+		WHERE id = $1`,
+		comment.ID, comment.Markdown, comment.HTML, comment.UpdatedAt, nullTime(comment.DeletedAt),
+	)
+
+	if err != nil {
+		return fmt.Errorf("error on UpdateComment: %w", err)
+	}
+
+	if rows != 1 {
+		return discussion.ErrCommentNotFound
+	}
+
+	return nil
+}
+
+func (r *CommentRepo) DeleteComment(ctx context.Context, id string) error {
+	_, err := r.Exec(ctx, `DELETE FROM discussion_comments WHERE id = $1`, id)
+
+	if err != nil {
+		return fmt.Errorf("error on DeleteComment: %w", err)
+	}
+
+	return nil
+}
+
+// GetCommentByID returns nil for IDs that are not UUIDs, like the paths of the
+// posts comments are made on, as no comment can have them.
+func (r *CommentRepo) GetCommentByID(ctx context.Context, id string) (*discussion.Comment, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, nil
+	}
+
+	conn := r.Conn(ctx)
+
+	row := conn.QueryRowContext(ctx, `
+		SELECT
+			id, subject_id, author_id, markdown, html, status, created_at, updated_at, deleted_at
+		FROM discussion_comments
+		WHERE id = $1`,
+		id,
+	)
+
+	comment := &discussion.Comment{Replies: []*discussion.Comment{}}
+	var deletedAt sql.NullTime
+
+	err := row.Scan(
+		&comment.ID,
+		&comment.SubjectID,
+		&comment.AuthorID,
+		&comment.Markdown,
+		&comment.HTML,
+		&comment.Status,
+		&comment.CreatedAt,
+		&comment.UpdatedAt,
+		&deletedAt,
+	)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error on GetCommentByID when executing query: %w", err)
+	}
+
+	comment.DeletedAt = deletedAt.Time
+
+	return comment, nil
+}
+
+func (r *CommentRepo) HasReplies(ctx context.Context, id string) (bool, error) {
+	conn := r.Conn(ctx)
+
+	row := conn.QueryRowContext(ctx, `
+		SELECT EXISTS(SELECT 1 FROM discussion_comments WHERE subject_id = $1)`,
+		id,
+	)
+
+	var hasReplies bool
+
+	err := row.Scan(&hasReplies)
+
+	if err != nil {
+		return false, fmt.Errorf("error on HasReplies when executing query: %w", err)
+	}
+
+	return hasReplies, nil
+}
+
+func (r *CommentRepo) SaveCommentRevision(ctx context.Context, revision *discussion.CommentRevision) error {
+	err := r.Insert(ctx, "discussion_comment_revisions", map[string]interface{}{
+		"id":         revision.ID,
+		"comment_id": revision.CommentID,
+		"markdown":   revision.Markdown,
+		"html":       revision.HTML,
+		"created_at": revision.CreatedAt,
+	})
+
+	if err != nil {
+		return fmt.Errorf("error on SaveCommentRevision: %w", err)
+	}
+
+	return nil
+}
+
+func (r *CommentRepo) GetCommentRevisions(ctx context.Context, commentID string) ([]*discussion.CommentRevision, error) {
+	conn := r.Conn(ctx)
+
+	rows, err := conn.QueryContext(ctx, `
+		SELECT
+			id, comment_id, markdown, html, created_at
+		FROM discussion_comment_revisions
+		WHERE comment_id = $1
+		ORDER BY created_at`,
+		commentID,
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("error on GetCommentRevisions when executing query: %w", err)
+	}
+
+	defer rows.Close()
+
+	result := []*discussion.CommentRevision{}
+
+	for rows.Next() {
+		revision := &discussion.CommentRevision{}
+
+		err := rows.Scan(&revision.ID, &revision.CommentID, &revision.Markdown, &revision.HTML, &revision.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("error on GetCommentRevisions when scanning row: %w", err)
+		}
+
+		result = append(result, revision)
+	}
+
+	return result, nil
+}
+
+func (r *CommentRepo) GetCommentsAndRepliesRecursively(ctx context.Context, subjectID, viewerUserID string) ([]*discussion.Comment, error) {
+	return newGetCommentsAndRepliesRecursivelyQuery(r.Conn(ctx), ctx, subjectID, viewerUserID).run()
+}
+
+func (r *CommentRepo) GetCommentsByStatus(ctx context.Context, status discussion.CommentStatus) ([]*discussion.Comment, error) {
+	conn := r.Conn(ctx)
+
+	rows, err := conn.QueryContext(ctx, `
+		SELECT
+			c.id, c.subject_id, c.author_id, c.markdown, c.html, c.status, c.created_at, c.updated_at,
+			a.id, a.auth_user_id, a.name, a.avatar_url, a.banned
+		FROM discussion_comments c
+		JOIN discussion_authors a ON c.author_id = a.id
+		WHERE c.status = $1
+		ORDER BY c.created_at`,
+		status,
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("error on GetCommentsByStatus when executing query: %w", err)
+	}
+
+	defer rows.Close()
+
+	result := []*discussion.Comment{}
+
+	for rows.Next() {
+		comment := &discussion.Comment{
+			Author:  &discussion.Author{Persisted: true},
+			Replies: []*discussion.Comment{},
+		}
+
+		err := rows.Scan(
+			&comment.ID,
+			&comment.SubjectID,
+			&comment.AuthorID,
+			&comment.Markdown,
+			&comment.HTML,
+			&comment.Status,
+			&comment.CreatedAt,
+			&comment.UpdatedAt,
+			&comment.Author.ID,
+			&comment.Author.UserID,
+			&comment.Author.Name,
+			&comment.Author.AvatarURL,
+			&comment.Author.Banned,
+		)
+
+		if err != nil {
+			return nil, fmt.Errorf("error on GetCommentsByStatus when scanning row: %w", err)
+		}
+
+		result = append(result, comment)
+	}
+
+	return result, nil
+}
+
+// GetRecentComments follows the replies from the comments written directly on a
+// subject, i.e. the ones not replying to another comment, to know the subject
+// each thread starts from.
+func (r *CommentRepo) GetRecentComments(ctx context.Context, subjectID string, limit int) ([]*discussion.Comment, error) {
+	conn := r.Conn(ctx)
+
+	rows, err := conn.QueryContext(ctx, `
+		WITH RECURSIVE threads AS (
+			SELECT c.id, c.subject_id AS root_subject_id
+			FROM discussion_comments c
+			WHERE NOT EXISTS (SELECT 1 FROM discussion_comments p WHERE p.id::TEXT = c.subject_id)
+			AND ($1 = '' OR c.subject_id = $1)
+
+			UNION
+
+			SELECT c.id, t.root_subject_id
+			FROM discussion_comments c
+			JOIN threads t ON c.subject_id = t.id::TEXT
+		)
+		SELECT
+			c.id, c.subject_id, c.author_id, c.markdown, c.html, c.status, c.created_at, c.updated_at,
+			a.id, a.auth_user_id, a.name, a.avatar_url, a.banned, t.root_subject_id
+		FROM threads t
+		JOIN discussion_comments c ON c.id = t.id
+		JOIN discussion_authors a ON c.author_id = a.id
+		WHERE c.deleted_at IS NULL
+		AND c.status = 'approved'
+		AND NOT a.banned
+		ORDER BY c.created_at DESC
+		LIMIT $2`,
+		subjectID,
+		limit,
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("error on GetRecentComments when executing query: %w", err)
+	}
+
+	defer rows.Close()
+
+	result := []*discussion.Comment{}
+
+	for rows.Next() {
+		comment := &discussion.Comment{
+			Author:  &discussion.Author{Persisted: true},
+			Replies: []*discussion.Comment{},
+		}
+
+		err := rows.Scan(
+			&comment.ID,
+			&comment.SubjectID,
+			&comment.AuthorID,
+			&comment.Markdown,
+			&comment.HTML,
+			&comment.Status,
+			&comment.CreatedAt,
+			&comment.UpdatedAt,
+			&comment.Author.ID,
+			&comment.Author.UserID,
+			&comment.Author.Name,
+			&comment.Author.AvatarURL,
+			&comment.Author.Banned,
+			&comment.RootSubjectID,
+		)
+
+		if err != nil {
+			return nil, fmt.Errorf("error on GetRecentComments when scanning row: %w", err)
+		}
+
+		result = append(result, comment)
+	}
+
+	return result, nil
+}
+
+func (r *CommentRepo) UpdateCommentStatus(ctx context.Context, id string, status discussion.CommentStatus) error {
+	// updated_at is left untouched so moderation doesn't flag the comment as edited.
+	rows, err := r.Exec(ctx, `UPDATE discussion_comments SET status = $2 WHERE id = $1`, id, status)
+
+	if err != nil {
+		return fmt.Errorf("error on UpdateCommentStatus: %w", err)
+	}
+
+	if rows != 1 {
+		return discussion.ErrCommentNotFound
+	}
+
+	return nil
+}
+
+func (r *CommentRepo) HasApprovedComments(ctx context.Context, authorID string) (bool, error) {
+	conn := r.Conn(ctx)
+
+	row := conn.QueryRowContext(ctx, `
+		SELECT EXISTS(SELECT 1 FROM discussion_comments WHERE author_id = $1 AND status = $2)`,
+		authorID, discussion.CommentApproved,
+	)
+
+	var approved bool
+
+	err := row.Scan(&approved)
+
+	if err != nil {
+		return false, fmt.Errorf("error on HasApprovedComments when executing query: %w", err)
+	}
+
+	return approved, nil
+}
+
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
 }
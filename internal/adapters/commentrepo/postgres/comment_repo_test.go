@@ -186,7 +186,7 @@ func (s *CommentRepoSuite) TestGetCommentsAndRepliesRecursively() {
 			s.Nil(s.repo.SaveComment(ctx, s.comment1))
 			s.Nil(s.repo.SaveComment(ctx, s.comment2))
 
-			comments, err := s.repo.GetCommentsAndRepliesRecursively(ctx, s.subjectID)
+			comments, err := s.repo.GetCommentsAndRepliesRecursively(ctx, s.subjectID, "")
 
 			s.Nil(err)
 			s.Equal([]*discussion.Comment{
@@ -205,7 +205,7 @@ func (s *CommentRepoSuite) TestGetCommentsAndRepliesRecursively() {
 			s.Nil(s.repo.SaveComment(ctx, s.reply1))
 			s.Nil(s.repo.SaveComment(ctx, s.reply2))
 
-			comments, err := s.repo.GetCommentsAndRepliesRecursively(ctx, s.subjectID)
+			comments, err := s.repo.GetCommentsAndRepliesRecursively(ctx, s.subjectID, "")
 
 			s.Nil(err)
 			s.Equal([]*discussion.Comment{
@@ -213,6 +213,358 @@ func (s *CommentRepoSuite) TestGetCommentsAndRepliesRecursively() {
 			}, comments)
 		})
 	})
+
+	s.Run("It hides comments of banned authors", func() {
+		dbrepo.Test(func(ctx context.Context, db *sql.DB) {
+			s.repo = postgres.NewCommentRepo(db)
+			s.author.Banned = true
+
+			s.Nil(s.repo.SaveAuthor(ctx, s.author))
+			s.Nil(s.repo.SaveComment(ctx, s.comment1))
+
+			comments, err := s.repo.GetCommentsAndRepliesRecursively(ctx, s.subjectID, s.author.UserID)
+
+			s.Nil(err)
+			s.Empty(comments)
+		})
+	})
+
+	s.Run("It hides comments that are not approved from other viewers", func() {
+		dbrepo.Test(func(ctx context.Context, db *sql.DB) {
+			s.repo = postgres.NewCommentRepo(db)
+			s.comment2.Status = discussion.CommentPending
+
+			s.Nil(s.repo.SaveAuthor(ctx, s.author))
+			s.Nil(s.repo.SaveComment(ctx, s.comment1))
+			s.Nil(s.repo.SaveComment(ctx, s.comment2))
+
+			comments, err := s.repo.GetCommentsAndRepliesRecursively(ctx, s.subjectID, s.uuidGen.Generate())
+
+			s.Nil(err)
+			s.Equal([]*discussion.Comment{s.comment1}, comments)
+
+			comments, err = s.repo.GetCommentsAndRepliesRecursively(ctx, s.subjectID, s.author.UserID)
+
+			s.Nil(err)
+			s.Equal([]*discussion.Comment{s.comment1, s.comment2}, comments)
+		})
+	})
+
+	s.Run("It keeps hidden comments as placeholders for their visible replies", func() {
+		dbrepo.Test(func(ctx context.Context, db *sql.DB) {
+			s.repo = postgres.NewCommentRepo(db)
+			bannedAuthor := NewAuthor(discussion.Author{ID: s.uuidGen.Generate(), UserID: s.uuidGen.Generate()})
+			bannedAuthor.Banned = true
+			s.comment1.Status = discussion.CommentRejected
+			s.reply1.AuthorID = bannedAuthor.ID
+
+			s.Nil(s.repo.SaveAuthor(ctx, s.author))
+			s.Nil(s.repo.SaveAuthor(ctx, bannedAuthor))
+			s.Nil(s.repo.SaveComment(ctx, s.comment1))
+			s.Nil(s.repo.SaveComment(ctx, s.reply1))
+			s.Nil(s.repo.SaveComment(ctx, s.reply2))
+
+			comments, err := s.repo.GetCommentsAndRepliesRecursively(ctx, s.subjectID, "")
+
+			s.Nil(err)
+			s.Equal([]*discussion.Comment{
+				{
+					ID:        s.comment1.ID,
+					SubjectID: s.comment1.SubjectID,
+					CreatedAt: s.comment1.CreatedAt,
+					UpdatedAt: s.comment1.CreatedAt,
+					Hidden:    true,
+					Replies: []*discussion.Comment{
+						{
+							ID:        s.reply1.ID,
+							SubjectID: s.reply1.SubjectID,
+							CreatedAt: s.reply1.CreatedAt,
+							UpdatedAt: s.reply1.CreatedAt,
+							Hidden:    true,
+							Replies:   []*discussion.Comment{s.reply2},
+						},
+					},
+				},
+			}, comments)
+		})
+	})
+
+	s.Run("It hides rejected and spam comments from their author too", func() {
+		for _, status := range []discussion.CommentStatus{discussion.CommentRejected, discussion.CommentSpam} {
+			dbrepo.Test(func(ctx context.Context, db *sql.DB) {
+				s.repo = postgres.NewCommentRepo(db)
+				comment2 := *s.comment2
+				comment2.Status = status
+
+				s.Nil(s.repo.SaveAuthor(ctx, s.author))
+				s.Nil(s.repo.SaveComment(ctx, s.comment1))
+				s.Nil(s.repo.SaveComment(ctx, &comment2))
+
+				comments, err := s.repo.GetCommentsAndRepliesRecursively(ctx, s.subjectID, s.author.UserID)
+
+				s.Nil(err)
+				s.Equal([]*discussion.Comment{s.comment1}, comments)
+			})
+		}
+	})
+}
+
+func (s *CommentRepoSuite) TestGetCommentByID() {
+	s.Run("It returns nil when the comment doesn't exist", func() {
+		dbrepo.Test(func(ctx context.Context, db *sql.DB) {
+			repo := postgres.NewCommentRepo(db)
+
+			comment, err := repo.GetCommentByID(ctx, s.uuidGen.Generate())
+
+			s.Nil(err)
+			s.Nil(comment)
+		})
+	})
+
+	s.Run("It returns nil when the ID is not a UUID", func() {
+		dbrepo.Test(func(ctx context.Context, db *sql.DB) {
+			repo := postgres.NewCommentRepo(db)
+
+			comment, err := repo.GetCommentByID(ctx, "post-path")
+
+			s.Nil(err)
+			s.Nil(comment)
+		})
+	})
+
+	s.Run("It returns the comment without author and replies", func() {
+		dbrepo.Test(func(ctx context.Context, db *sql.DB) {
+			repo := postgres.NewCommentRepo(db)
+
+			s.Nil(repo.SaveAuthor(ctx, s.author))
+			s.Nil(repo.SaveComment(ctx, s.comment1))
+
+			comment, err := repo.GetCommentByID(ctx, s.comment1.ID)
+
+			expected := NewComment(*s.comment1)
+			expected.Author = nil
+
+			s.Nil(err)
+			s.Equal(expected, comment)
+		})
+	})
+}
+
+func (s *CommentRepoSuite) TestUpdateComment() {
+	s.Run("It updates the content and timestamps", func() {
+		dbrepo.Test(func(ctx context.Context, db *sql.DB) {
+			repo := postgres.NewCommentRepo(db)
+
+			s.Nil(repo.SaveAuthor(ctx, s.author))
+			s.Nil(repo.SaveComment(ctx, s.comment1))
+
+			comment, _ := repo.GetCommentByID(ctx, s.comment1.ID)
+			comment.Markdown = "Updated markdown"
+			comment.HTML = "Updated HTML"
+			comment.UpdatedAt = comment.CreatedAt.Add(time.Hour)
+			comment.DeletedAt = comment.CreatedAt.Add(2 * time.Hour)
+
+			s.Nil(repo.UpdateComment(ctx, comment))
+
+			updatedComment, err := repo.GetCommentByID(ctx, s.comment1.ID)
+
+			s.Nil(err)
+			s.Equal(comment, updatedComment)
+		})
+	})
+
+	s.Run("It returns ErrCommentNotFound when the comment doesn't exist", func() {
+		dbrepo.Test(func(ctx context.Context, db *sql.DB) {
+			repo := postgres.NewCommentRepo(db)
+
+			err := repo.UpdateComment(ctx, s.comment1)
+
+			s.Equal(discussion.ErrCommentNotFound, err)
+		})
+	})
+}
+
+func (s *CommentRepoSuite) TestDeleteComment() {
+	s.Run("It deletes the comment and its revisions", func() {
+		dbrepo.Test(func(ctx context.Context, db *sql.DB) {
+			repo := postgres.NewCommentRepo(db)
+
+			s.Nil(repo.SaveAuthor(ctx, s.author))
+			s.Nil(repo.SaveComment(ctx, s.comment1))
+			s.Nil(repo.SaveCommentRevision(ctx, s.revision(s.comment1)))
+
+			s.Nil(repo.DeleteComment(ctx, s.comment1.ID))
+
+			comment, _ := repo.GetCommentByID(ctx, s.comment1.ID)
+			revisions, _ := repo.GetCommentRevisions(ctx, s.comment1.ID)
+
+			s.Nil(comment)
+			s.Empty(revisions)
+		})
+	})
+}
+
+func (s *CommentRepoSuite) TestHasReplies() {
+	s.Run("It tells whether the comment has replies", func() {
+		dbrepo.Test(func(ctx context.Context, db *sql.DB) {
+			repo := postgres.NewCommentRepo(db)
+
+			s.Nil(repo.SaveAuthor(ctx, s.author))
+			s.Nil(repo.SaveComment(ctx, s.comment1))
+			s.Nil(repo.SaveComment(ctx, s.comment2))
+			s.Nil(repo.SaveComment(ctx, s.reply1))
+
+			hasReplies, err := repo.HasReplies(ctx, s.comment1.ID)
+			s.Nil(err)
+			s.True(hasReplies)
+
+			hasReplies, err = repo.HasReplies(ctx, s.comment2.ID)
+			s.Nil(err)
+			s.False(hasReplies)
+		})
+	})
+}
+
+func (s *CommentRepoSuite) TestCommentRevisions() {
+	s.Run("It saves and fetches the revisions of a comment", func() {
+		dbrepo.Test(func(ctx context.Context, db *sql.DB) {
+			repo := postgres.NewCommentRepo(db)
+			revision := s.revision(s.comment1)
+
+			s.Nil(repo.SaveAuthor(ctx, s.author))
+			s.Nil(repo.SaveComment(ctx, s.comment1))
+			s.Nil(repo.SaveCommentRevision(ctx, revision))
+
+			revisions, err := repo.GetCommentRevisions(ctx, s.comment1.ID)
+
+			s.Nil(err)
+			s.Equal([]*discussion.CommentRevision{revision}, revisions)
+		})
+	})
+}
+
+func (s *CommentRepoSuite) TestGetCommentsByStatus() {
+	s.Run("It returns the comments with the given status and their authors", func() {
+		dbrepo.Test(func(ctx context.Context, db *sql.DB) {
+			repo := postgres.NewCommentRepo(db)
+			s.comment2.Status = discussion.CommentPending
+
+			s.Nil(repo.SaveAuthor(ctx, s.author))
+			s.Nil(repo.SaveComment(ctx, s.comment1))
+			s.Nil(repo.SaveComment(ctx, s.comment2))
+
+			comments, err := repo.GetCommentsByStatus(ctx, discussion.CommentPending)
+
+			s.Nil(err)
+			s.Equal([]*discussion.Comment{s.comment2}, comments)
+		})
+	})
+}
+
+func (s *CommentRepoSuite) TestGetRecentComments() {
+	s.Run("It returns the comments and replies newest first with the subject of their thread", func() {
+		dbrepo.Test(func(ctx context.Context, db *sql.DB) {
+			repo := postgres.NewCommentRepo(db)
+
+			s.Nil(repo.SaveAuthor(ctx, s.author))
+			s.Nil(repo.SaveComment(ctx, s.comment1))
+			s.Nil(repo.SaveComment(ctx, s.reply1))
+			s.Nil(repo.SaveComment(ctx, s.reply2))
+
+			comments, err := repo.GetRecentComments(ctx, s.subjectID, 2)
+
+			s.Nil(err)
+			s.reply1.RootSubjectID = s.subjectID
+			s.reply2.RootSubjectID = s.subjectID
+			s.Equal([]*discussion.Comment{s.reply2, s.reply1}, comments)
+		})
+	})
+
+	s.Run("It leaves out other subjects and hidden comments but not their replies", func() {
+		dbrepo.Test(func(ctx context.Context, db *sql.DB) {
+			repo := postgres.NewCommentRepo(db)
+			s.comment1.Status = discussion.CommentPending
+			s.comment2.SubjectID = "OTHER_SUBJECT_ID"
+
+			s.Nil(repo.SaveAuthor(ctx, s.author))
+			s.Nil(repo.SaveComment(ctx, s.comment1))
+			s.Nil(repo.SaveComment(ctx, s.comment2))
+			s.Nil(repo.SaveComment(ctx, s.reply1))
+
+			comments, err := repo.GetRecentComments(ctx, s.subjectID, 10)
+
+			s.Nil(err)
+			s.reply1.RootSubjectID = s.subjectID
+			s.Equal([]*discussion.Comment{s.reply1}, comments)
+
+			comments, err = repo.GetRecentComments(ctx, "", 10)
+
+			s.Nil(err)
+			s.comment2.RootSubjectID = "OTHER_SUBJECT_ID"
+			s.Equal([]*discussion.Comment{s.reply1, s.comment2}, comments)
+		})
+	})
+}
+
+func (s *CommentRepoSuite) TestUpdateCommentStatus() {
+	s.Run("It updates the status of the comment", func() {
+		dbrepo.Test(func(ctx context.Context, db *sql.DB) {
+			repo := postgres.NewCommentRepo(db)
+
+			s.Nil(repo.SaveAuthor(ctx, s.author))
+			s.Nil(repo.SaveComment(ctx, s.comment1))
+
+			s.Nil(repo.UpdateCommentStatus(ctx, s.comment1.ID, discussion.CommentSpam))
+
+			comment, err := repo.GetCommentByID(ctx, s.comment1.ID)
+
+			s.Nil(err)
+			s.Equal(discussion.CommentSpam, comment.Status)
+			s.False(comment.IsEdited())
+		})
+	})
+
+	s.Run("It returns ErrCommentNotFound when the comment doesn't exist", func() {
+		dbrepo.Test(func(ctx context.Context, db *sql.DB) {
+			repo := postgres.NewCommentRepo(db)
+
+			err := repo.UpdateCommentStatus(ctx, s.comment1.ID, discussion.CommentApproved)
+
+			s.Equal(discussion.ErrCommentNotFound, err)
+		})
+	})
+}
+
+func (s *CommentRepoSuite) TestHasApprovedComments() {
+	s.Run("It tells whether the author has approved comments", func() {
+		dbrepo.Test(func(ctx context.Context, db *sql.DB) {
+			repo := postgres.NewCommentRepo(db)
+			s.comment1.Status = discussion.CommentPending
+
+			s.Nil(repo.SaveAuthor(ctx, s.author))
+			s.Nil(repo.SaveComment(ctx, s.comment1))
+
+			approved, err := repo.HasApprovedComments(ctx, s.author.ID)
+			s.Nil(err)
+			s.False(approved)
+
+			s.Nil(repo.SaveComment(ctx, s.comment2))
+
+			approved, err = repo.HasApprovedComments(ctx, s.author.ID)
+			s.Nil(err)
+			s.True(approved)
+		})
+	})
+}
+
+func (s *CommentRepoSuite) revision(comment *discussion.Comment) *discussion.CommentRevision {
+	return &discussion.CommentRevision{
+		ID:        s.uuidGen.Generate(),
+		CommentID: comment.ID,
+		Markdown:  comment.Markdown,
+		HTML:      comment.HTML,
+		CreatedAt: comment.CreatedAt.Add(time.Hour),
+	}
 }
 
 func TestCommentRepoSuite(t *testing.T) {
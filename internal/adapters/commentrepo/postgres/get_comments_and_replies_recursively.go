@@ -10,19 +10,24 @@ import (
 )
 
 type getCommentsAndRepliesRecursivelyQuery struct {
-	conn       dbrepo.Connection
-	ctx        context.Context
-	subjectID  string
-	result     []*discussion.Comment
-	rows       *sql.Rows
-	commentMap map[string][]*discussion.Comment
+	conn         dbrepo.Connection
+	ctx          context.Context
+	subjectID    string
+	viewerUserID string
+	result       []*discussion.Comment
+	rows         *sql.Rows
+	commentMap   map[string][]*discussion.Comment
+	visible      map[string]bool
 }
 
-func newGetCommentsAndRepliesRecursivelyQuery(conn dbrepo.Connection, ctx context.Context, subjectID string) *getCommentsAndRepliesRecursivelyQuery {
+func newGetCommentsAndRepliesRecursivelyQuery(
+	conn dbrepo.Connection, ctx context.Context, subjectID, viewerUserID string,
+) *getCommentsAndRepliesRecursivelyQuery {
 	return &getCommentsAndRepliesRecursivelyQuery{
-		conn:      conn,
-		ctx:       ctx,
-		subjectID: subjectID,
+		conn:         conn,
+		ctx:          ctx,
+		subjectID:    subjectID,
+		viewerUserID: viewerUserID,
 	}
 }
 
@@ -45,32 +50,33 @@ func (q *getCommentsAndRepliesRecursivelyQuery) run() ([]*discussion.Comment, er
 func (q *getCommentsAndRepliesRecursivelyQuery) initializeVariables() {
 	q.result = []*discussion.Comment{}
 	q.commentMap = map[string][]*discussion.Comment{}
+	q.visible = map[string]bool{}
 	q.rows = nil
 }
 
 func (q *getCommentsAndRepliesRecursivelyQuery) executeQuery() error {
 	rows, err := q.conn.QueryContext(q.ctx, `
 		WITH RECURSIVE comments_and_replies as (
-			SELECT 
-				c.id, c.subject_id, c.author_id, c.markdown, c.html, c.created_at,
-				a.id AS author_id, a.auth_user_id, a.name, a.avatar_url
+			SELECT c.id
 			FROM discussion_comments c
-			JOIN discussion_authors a ON c.author_id = a.id
 			WHERE c.subject_id = $1
-			
+
 			UNION
 
-			SELECT 
-				c.id, c.subject_id, c.author_id, c.markdown, c.html, c.created_at,
-				a.id AS author_id, a.auth_user_id, a.name, a.avatar_url
+			SELECT c.id
 			FROM discussion_comments c
-			JOIN discussion_authors a ON c.author_id = a.id
 			JOIN comments_and_replies cr ON c.subject_id = cr.id::TEXT
-		) 
-		SELECT * 
-		FROM comments_and_replies
-		ORDER BY created_at`,
+		)
+		SELECT
+			c.id, c.subject_id, c.author_id, c.markdown, c.html, c.status, c.created_at, c.updated_at, c.deleted_at,
+			a.id AS author_id, a.auth_user_id, a.name, a.avatar_url, a.banned,
+			COALESCE(NOT a.banned AND (c.status = 'approved' OR (c.status = 'pending' AND a.auth_user_id::TEXT = $2)), FALSE) AS visible
+		FROM comments_and_replies cr
+		JOIN discussion_comments c ON c.id = cr.id
+		JOIN discussion_authors a ON c.author_id = a.id
+		ORDER BY c.created_at`,
 		q.subjectID,
+		q.viewerUserID,
 	)
 
 	q.rows = rows
@@ -84,21 +90,24 @@ func (q *getCommentsAndRepliesRecursivelyQuery) executeQuery() error {
 
 func (q *getCommentsAndRepliesRecursivelyQuery) scanRowsAndBuildCommentMap() error {
 	for q.rows.Next() {
-		comment, err := q.scanRow(q.rows)
+		comment, visible, err := q.scanRow(q.rows)
 		if err != nil {
 			return err
 		}
 
 		q.addToCommentMap(comment)
+		q.visible[comment.ID] = visible
 	}
 
 	return nil
 }
 
-func (q *getCommentsAndRepliesRecursivelyQuery) scanRow(row *sql.Rows) (*discussion.Comment, error) {
+func (q *getCommentsAndRepliesRecursivelyQuery) scanRow(row *sql.Rows) (*discussion.Comment, bool, error) {
 	comment := &discussion.Comment{
 		Author: &discussion.Author{Persisted: true},
 	}
+	var deletedAt sql.NullTime
+	var visible bool
 
 	err := q.rows.Scan(
 		&comment.ID,
@@ -106,18 +115,25 @@ func (q *getCommentsAndRepliesRecursivelyQuery) scanRow(row *sql.Rows) (*discuss
 		&comment.AuthorID,
 		&comment.Markdown,
 		&comment.HTML,
+		&comment.Status,
 		&comment.CreatedAt,
+		&comment.UpdatedAt,
+		&deletedAt,
 		&comment.Author.ID,
 		&comment.Author.UserID,
 		&comment.Author.Name,
 		&comment.Author.AvatarURL,
+		&comment.Author.Banned,
+		&visible,
 	)
 
 	if err != nil {
-		return comment, fmt.Errorf("error on GetCommentsAndRepliesRecursively when scanning row: %w", err)
+		return comment, false, fmt.Errorf("error on GetCommentsAndRepliesRecursively when scanning row: %w", err)
 	}
 
-	return comment, err
+	comment.DeletedAt = deletedAt.Time
+
+	return comment, visible, err
 }
 
 func (q *getCommentsAndRepliesRecursivelyQuery) addToCommentMap(comment *discussion.Comment) {
@@ -128,9 +144,14 @@ func (q *getCommentsAndRepliesRecursivelyQuery) addToCommentMap(comment *discuss
 	q.commentMap[comment.SubjectID] = append(q.commentMap[comment.SubjectID], comment)
 }
 
+// buildResult walks the whole thread before hiding any comment, so the visible
+// replies of hidden ones are kept.
 func (q *getCommentsAndRepliesRecursivelyQuery) buildResult() {
-	q.result = q.commentMap[q.subjectID]
-	q.appendRepliesRecursively(q.result)
+	q.appendRepliesRecursively(q.commentMap[q.subjectID])
+
+	q.result = discussion.HideComments(q.commentMap[q.subjectID], func(comment *discussion.Comment) bool {
+		return q.visible[comment.ID]
+	})
 }
 
 func (q *getCommentsAndRepliesRecursivelyQuery) appendRepliesRecursively(comments []*discussion.Comment) {
@@ -0,0 +1,252 @@
+package memory
+
+import (
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/geisonbiazus/blog/internal/core/blog"
+)
+
+// Weights of each field when scoring the posts. A word in the title is worth
+// more than the same word in the body.
+const (
+	titleWeight       = 10
+	tagWeight         = 5
+	descriptionWeight = 3
+	bodyWeight        = 1
+)
+
+const snippetLength = 200
+
+// PostIndex is an inverted index of the posts kept in memory.
+type PostIndex struct {
+	mutex    sync.RWMutex
+	postings map[string]map[string]float64
+	texts    map[string]string
+}
+
+func NewPostIndex() *PostIndex {
+	return &PostIndex{
+		postings: map[string]map[string]float64{},
+		texts:    map[string]string{},
+	}
+}
+
+func (i *PostIndex) Index(posts []blog.Post) error {
+	postings := map[string]map[string]float64{}
+	texts := map[string]string{}
+
+	for _, post := range posts {
+		addPostings(postings, post.Path, post.Title, titleWeight)
+		addPostings(postings, post.Path, strings.Join(post.Tags, " "), tagWeight)
+		addPostings(postings, post.Path, post.Description, descriptionWeight)
+		addPostings(postings, post.Path, post.Markdown, bodyWeight)
+
+		texts[post.Path] = blog.PlainText(post.Markdown)
+		if texts[post.Path] == "" {
+			texts[post.Path] = post.Description
+		}
+	}
+
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	i.postings = postings
+	i.texts = texts
+
+	return nil
+}
+
+func addPostings(postings map[string]map[string]float64, path, text string, weight float64) {
+	for _, term := range tokenize(text) {
+		if postings[term] == nil {
+			postings[term] = map[string]float64{}
+		}
+		postings[term][path] += weight
+	}
+}
+
+// Search returns the posts containing every term of the query. Terms also
+// match words starting with them, so "test" finds "testing".
+func (i *PostIndex) Search(query string) ([]blog.SearchMatch, error) {
+	i.mutex.RLock()
+	defer i.mutex.RUnlock()
+
+	terms := tokenize(query)
+	scores := map[string]float64{}
+
+	for n, term := range terms {
+		termScores := i.scoreTerm(term)
+
+		for path := range scores {
+			if _, ok := termScores[path]; !ok {
+				delete(scores, path)
+			}
+		}
+
+		for path, score := range termScores {
+			if _, ok := scores[path]; ok || n == 0 {
+				scores[path] += score
+			}
+		}
+	}
+
+	return i.toMatches(scores, terms), nil
+}
+
+// scoreTerm weights the frequency of the term in each post by how rare it is
+// across all posts.
+func (i *PostIndex) scoreTerm(term string) map[string]float64 {
+	frequencies := map[string]float64{}
+
+	for indexedTerm, postings := range i.postings {
+		if !strings.HasPrefix(indexedTerm, term) {
+			continue
+		}
+
+		for path, frequency := range postings {
+			frequencies[path] += frequency
+		}
+	}
+
+	rarity := math.Log(1 + float64(len(i.texts))/float64(len(frequencies)))
+
+	for path := range frequencies {
+		frequencies[path] *= rarity
+	}
+
+	return frequencies
+}
+
+func (i *PostIndex) toMatches(scores map[string]float64, terms []string) []blog.SearchMatch {
+	matches := []blog.SearchMatch{}
+
+	for path, score := range scores {
+		matches = append(matches, blog.SearchMatch{
+			Path:    path,
+			Score:   score,
+			Snippet: buildSnippet(i.texts[path], terms),
+		})
+	}
+
+	sort.Slice(matches, func(a, b int) bool {
+		if matches[a].Score != matches[b].Score {
+			return matches[a].Score > matches[b].Score
+		}
+		return matches[a].Path < matches[b].Path
+	})
+
+	return matches
+}
+
+var wordRegexp = regexp.MustCompile(`[\p{L}\p{N}]+`)
+
+func tokenize(text string) []string {
+	return wordRegexp.FindAllString(strings.ToLower(text), -1)
+}
+
+// buildSnippet returns the part of the text around the first word matching the
+// query, with the matching words highlighted.
+func buildSnippet(text string, terms []string) blog.Snippet {
+	words := wordRegexp.FindAllStringIndex(text, -1)
+	start := 0
+
+	for _, word := range words {
+		if matchesAnyTerm(text[word[0]:word[1]], terms) {
+			start = snippetStart(text, word[0])
+			break
+		}
+	}
+
+	end := snippetEnd(text, start)
+	snippet := blog.Snippet{}
+
+	if start > 0 {
+		snippet = appendText(snippet, "…", false)
+	}
+
+	position := start
+
+	for _, word := range words {
+		if word[0] < start || word[1] > end {
+			continue
+		}
+
+		if matchesAnyTerm(text[word[0]:word[1]], terms) {
+			snippet = appendText(snippet, text[position:word[0]], false)
+			snippet = appendText(snippet, text[word[0]:word[1]], true)
+			position = word[1]
+		}
+	}
+
+	snippet = appendText(snippet, text[position:end], false)
+
+	if end < len(text) {
+		snippet = appendText(snippet, "…", false)
+	}
+
+	return snippet
+}
+
+func matchesAnyTerm(word string, terms []string) bool {
+	word = strings.ToLower(word)
+
+	for _, term := range terms {
+		if strings.HasPrefix(word, term) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// snippetStart keeps some context before the matching word, starting at the
+// beginning of a word.
+func snippetStart(text string, matchStart int) int {
+	start := matchStart - snippetLength/4
+
+	if start <= 0 {
+		return 0
+	}
+
+	if space := strings.IndexByte(text[start:matchStart], ' '); space >= 0 {
+		return start + space + 1
+	}
+
+	return matchStart
+}
+
+func snippetEnd(text string, start int) int {
+	end := start + snippetLength
+
+	if end >= len(text) {
+		return len(text)
+	}
+
+	if space := strings.LastIndexByte(text[start:end], ' '); space > 0 {
+		return start + space
+	}
+
+	for !utf8.RuneStart(text[end]) {
+		end--
+	}
+
+	return end
+}
+
+func appendText(snippet blog.Snippet, text string, highlighted bool) blog.Snippet {
+	if text == "" {
+		return snippet
+	}
+
+	if last := len(snippet) - 1; last >= 0 && !highlighted && !snippet[last].Highlighted {
+		snippet[last].Text += text
+		return snippet
+	}
+
+	return append(snippet, blog.SnippetFragment{Text: text, Highlighted: highlighted})
+}
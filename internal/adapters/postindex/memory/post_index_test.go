@@ -0,0 +1,138 @@
+package memory_test
+
+import (
+	"testing"
+
+	"github.com/geisonbiazus/blog/internal/adapters/postindex/memory"
+	"github.com/geisonbiazus/blog/internal/core/blog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostIndex(t *testing.T) {
+	search := func(t *testing.T, index *memory.PostIndex, query string) []blog.SearchMatch {
+		t.Helper()
+		matches, err := index.Search(query)
+		assert.Nil(t, err)
+		return matches
+	}
+
+	paths := func(matches []blog.SearchMatch) []string {
+		result := []string{}
+		for _, match := range matches {
+			result = append(result, match.Path)
+		}
+		return result
+	}
+
+	t.Run("It finds the posts containing the query in any field", func(t *testing.T) {
+		index := memory.NewPostIndex()
+		index.Index([]blog.Post{
+			{Path: "title", Title: "Testing in Go"},
+			{Path: "description", Description: "About testing"},
+			{Path: "tags", Tags: []string{"testing"}},
+			{Path: "body", Markdown: "Some **testing** content"},
+			{Path: "other", Title: "Other", Markdown: "Nothing to see"},
+		})
+
+		assert.ElementsMatch(t, []string{"title", "description", "tags", "body"}, paths(search(t, index, "Testing")))
+	})
+
+	t.Run("It ranks title over tags, description and body", func(t *testing.T) {
+		index := memory.NewPostIndex()
+		index.Index([]blog.Post{
+			{Path: "body", Markdown: "mocks"},
+			{Path: "description", Description: "mocks"},
+			{Path: "title", Title: "Mocks"},
+			{Path: "tags", Tags: []string{"mocks"}},
+		})
+
+		assert.Equal(t, []string{"title", "tags", "description", "body"}, paths(search(t, index, "mocks")))
+	})
+
+	t.Run("It ranks rare terms higher than common ones", func(t *testing.T) {
+		index := memory.NewPostIndex()
+		index.Index([]blog.Post{
+			{Path: "a-common", Markdown: "go go heap"},
+			{Path: "b-rare", Markdown: "go heap heap"},
+			{Path: "c", Markdown: "go"},
+			{Path: "d", Markdown: "go"},
+		})
+
+		assert.Equal(t, []string{"b-rare", "a-common"}, paths(search(t, index, "go heap")))
+	})
+
+	t.Run("It requires every term of the query", func(t *testing.T) {
+		index := memory.NewPostIndex()
+		index.Index([]blog.Post{
+			{Path: "both", Markdown: "binary search tree"},
+			{Path: "one", Markdown: "binary heap"},
+		})
+
+		assert.Equal(t, []string{"both"}, paths(search(t, index, "binary tree")))
+		assert.Empty(t, search(t, index, "binary graph"))
+	})
+
+	t.Run("It matches words starting with the query terms", func(t *testing.T) {
+		index := memory.NewPostIndex()
+		index.Index([]blog.Post{{Path: "post", Markdown: "Testing doubles"}})
+
+		assert.Equal(t, []string{"post"}, paths(search(t, index, "test")))
+	})
+
+	t.Run("It returns no matches for an empty query", func(t *testing.T) {
+		index := memory.NewPostIndex()
+		index.Index([]blog.Post{{Path: "post", Markdown: "content"}})
+
+		assert.Empty(t, search(t, index, " ?! "))
+	})
+
+	t.Run("It replaces the indexed posts", func(t *testing.T) {
+		index := memory.NewPostIndex()
+		index.Index([]blog.Post{{Path: "old", Markdown: "content"}})
+		index.Index([]blog.Post{{Path: "new", Markdown: "content"}})
+
+		assert.Equal(t, []string{"new"}, paths(search(t, index, "content")))
+	})
+
+	t.Run("It highlights the matching words in the snippet", func(t *testing.T) {
+		index := memory.NewPostIndex()
+		index.Index([]blog.Post{{Path: "post", Markdown: "## Mocks\n\nA [mock](https://example.com) is a *test double*.\n"}})
+
+		matches := search(t, index, "mock double")
+
+		assert.Equal(t, blog.Snippet{
+			{Text: "Mocks", Highlighted: true},
+			{Text: " A "},
+			{Text: "mock", Highlighted: true},
+			{Text: " is a test "},
+			{Text: "double", Highlighted: true},
+			{Text: "."},
+		}, matches[0].Snippet)
+	})
+
+	t.Run("It cuts the snippet around the first match", func(t *testing.T) {
+		index := memory.NewPostIndex()
+		long := ""
+		for i := 0; i < 40; i++ {
+			long += "lorem ipsum "
+		}
+		index.Index([]blog.Post{{Path: "post", Markdown: long + "needle " + long}})
+
+		snippet := search(t, index, "needle")[0].Snippet
+
+		assert.Equal(t, "…", snippet[0].Text[:len("…")])
+		assert.Equal(t, blog.SnippetFragment{Text: "needle", Highlighted: true}, snippet[1])
+		assert.Equal(t, "…", snippet[2].Text[len(snippet[2].Text)-len("…"):])
+		assert.LessOrEqual(t, len(snippet[0].Text+snippet[1].Text+snippet[2].Text), 200+2*len("…"))
+	})
+
+	t.Run("It uses the description in the snippet when there is no body", func(t *testing.T) {
+		index := memory.NewPostIndex()
+		index.Index([]blog.Post{{Path: "post", Title: "Heaps", Description: "All about heaps"}})
+
+		assert.Equal(t, blog.Snippet{
+			{Text: "All about "},
+			{Text: "heaps", Highlighted: true},
+		}, search(t, index, "heaps")[0].Snippet)
+	})
+}
@@ -0,0 +1,7 @@
+package postindex
+
+import "github.com/geisonbiazus/blog/internal/adapters/postindex/memory"
+
+func NewMemoryPostIndex() *memory.PostIndex {
+	return memory.NewPostIndex()
+}
@@ -0,0 +1,58 @@
+package filesystem
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/geisonbiazus/blog/internal/core/blog"
+)
+
+// FormatFileContent writes the post in the legacy "key: value" header format
+// understood by ParseFileContent. Empty fields are left out.
+func FormatFileContent(post blog.Post) string {
+	var b strings.Builder
+
+	writeHeader := func(key, value string) {
+		if value != "" {
+			fmt.Fprintf(&b, "%s: %s\n", key, value)
+		}
+	}
+
+	writeHeader("title", post.Title)
+	writeHeader("author", post.Author)
+	writeHeader("description", post.Description)
+	writeHeader("image_path", post.ImagePath)
+
+	if !post.Time.IsZero() {
+		writeHeader("time", post.Time.Format(timeFormat))
+	}
+
+	writeHeader("tags", strings.Join(post.Tags, ", "))
+	writeHeader("series", post.Series)
+
+	if post.SeriesOrder != 0 {
+		writeHeader("series_order", fmt.Sprint(post.SeriesOrder))
+	}
+
+	writeHeader("status", string(post.Status))
+
+	if post.TOC {
+		writeHeader("toc", "true")
+	}
+
+	b.WriteString("--\n")
+	b.WriteString(post.Markdown)
+
+	return b.String()
+}
+
+// Formatter writes posts with FormatFileContent.
+type Formatter struct{}
+
+func NewFormatter() *Formatter {
+	return &Formatter{}
+}
+
+func (f *Formatter) Format(post blog.Post) string {
+	return FormatFileContent(post)
+}
@@ -0,0 +1,51 @@
+package filesystem_test
+
+import (
+	"testing"
+
+	"github.com/geisonbiazus/blog/internal/adapters/postrepo/filesystem"
+	"github.com/geisonbiazus/blog/internal/core/blog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatFileContent(t *testing.T) {
+	t.Run("It formats the post in the legacy header format", func(t *testing.T) {
+		post := blog.Post{
+			Title:    "Post Title",
+			Time:     toTime("2021-04-04T22:00:00Z"),
+			Tags:     []string{"go", "testing"},
+			Status:   blog.PostDraft,
+			Markdown: "Content\n",
+		}
+
+		assert.Equal(t, ""+
+			"title: Post Title\n"+
+			"time: 2021-04-04 22:00\n"+
+			"tags: go, testing\n"+
+			"status: draft\n"+
+			"--\n"+
+			"Content\n",
+			filesystem.FormatFileContent(post))
+	})
+
+	t.Run("It can be parsed back into the same post", func(t *testing.T) {
+		post := blog.Post{
+			Title:       "Post Title",
+			Author:      "Author Name",
+			Description: "Description",
+			ImagePath:   "/image.png",
+			Time:        toTime("2021-04-04T22:00:00Z"),
+			Tags:        []string{"go", "data-structures"},
+			Series:      "Data Structures",
+			SeriesOrder: 2,
+			Status:      blog.PostPublished,
+			TOC:         true,
+			Markdown:    "## Subtitle\n\nContent\n",
+		}
+
+		parsed, err := filesystem.ParseFileContent(filesystem.FormatFileContent(post))
+
+		assert.Nil(t, err)
+		assert.Equal(t, post, parsed)
+	})
+}
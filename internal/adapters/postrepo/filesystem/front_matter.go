@@ -0,0 +1,136 @@
+package filesystem
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/geisonbiazus/blog/internal/core/blog"
+	"gopkg.in/yaml.v3"
+)
+
+var ErrInvalidFrontMatter = errors.New("invalid YAML front matter")
+var ErrUnsupportedFrontMatter = errors.New("TOML front matter is not supported, please use YAML \"---\" front matter")
+
+const yamlDelimiter = "---\n"
+const tomlDelimiter = "+++\n"
+
+// frontMatter holds the known keys. Scalars are decoded as strings so they go
+// through the same validations as the legacy header.
+type frontMatter struct {
+	Title       string  `yaml:"title"`
+	Author      string  `yaml:"author"`
+	Description string  `yaml:"description"`
+	ImagePath   string  `yaml:"image_path"`
+	Time        string  `yaml:"time"`
+	Tags        tagList `yaml:"tags"`
+	Series      string  `yaml:"series"`
+	SeriesOrder string  `yaml:"series_order"`
+	Status      string  `yaml:"status"`
+	TOC         string  `yaml:"toc"`
+}
+
+var frontMatterKeys = []string{
+	"title", "author", "description", "image_path", "time", "tags", "series", "series_order", "status", "toc",
+}
+
+// tagList accepts both a YAML list and the legacy comma separated string.
+type tagList []string
+
+func (l *tagList) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		*l = parseTagList(value.Value)
+		return nil
+	}
+
+	var tags []string
+
+	if err := value.Decode(&tags); err != nil {
+		return err
+	}
+
+	*l = parseTagList(strings.Join(tags, ","))
+	return nil
+}
+
+func parseFrontMatter(content string) (blog.Post, error) {
+	header, body, err := splitFrontMatter(content)
+
+	if err != nil {
+		return blog.Post{}, err
+	}
+
+	var fields frontMatter
+	params := map[string]interface{}{}
+
+	if err := yaml.Unmarshal([]byte(header), &fields); err != nil {
+		return blog.Post{}, fmt.Errorf("%w: %v", ErrInvalidFrontMatter, err)
+	}
+
+	if err := yaml.Unmarshal([]byte(header), &params); err != nil {
+		return blog.Post{}, fmt.Errorf("%w: %v", ErrInvalidFrontMatter, err)
+	}
+
+	post, err := fields.toPost()
+	post.Params = unknownParams(params)
+	post.Markdown = body
+
+	return post, err
+}
+
+func splitFrontMatter(content string) (header, body string, err error) {
+	rest := "\n" + strings.TrimPrefix(content, yamlDelimiter)
+	end := strings.Index(rest, "\n"+yamlDelimiter)
+
+	if end < 0 {
+		return "", "", ErrInvalidFormat
+	}
+
+	return strings.TrimPrefix(rest[:end], "\n"), rest[end+len("\n"+yamlDelimiter):], nil
+}
+
+func (f frontMatter) toPost() (blog.Post, error) {
+	post := blog.Post{
+		Title:       f.Title,
+		Author:      f.Author,
+		Description: f.Description,
+		ImagePath:   f.ImagePath,
+		Series:      f.Series,
+	}
+
+	if len(f.Tags) > 0 {
+		post.Tags = f.Tags
+	}
+
+	var err error
+
+	if post.Time, err = parseTimeValue(f.Time); err != nil {
+		return blog.Post{}, err
+	}
+
+	if post.SeriesOrder, err = parseSeriesOrderValue(f.SeriesOrder); err != nil {
+		return blog.Post{}, err
+	}
+
+	if post.Status, err = parseStatusValue(f.Status); err != nil {
+		return blog.Post{}, err
+	}
+
+	if post.TOC, err = parseTOCValue(f.TOC); err != nil {
+		return blog.Post{}, err
+	}
+
+	return post, nil
+}
+
+func unknownParams(params map[string]interface{}) map[string]interface{} {
+	for _, key := range frontMatterKeys {
+		delete(params, key)
+	}
+
+	if len(params) == 0 {
+		return nil
+	}
+
+	return params
+}
@@ -2,6 +2,7 @@ package filesystem
 
 import (
 	"errors"
+	"strconv"
 	"strings"
 	"time"
 
@@ -10,8 +11,21 @@ import (
 
 var ErrInvalidTime = errors.New("invalid time format, please use YYYY-MM-DD HH:MM")
 var ErrInvalidFormat = errors.New("invalid file format, please include a header / body separator \"--\"")
+var ErrInvalidSeriesOrder = errors.New("invalid series order, please use a positive integer")
+var ErrInvalidStatus = errors.New("invalid status, please use \"draft\" or \"published\"")
+var ErrInvalidTOC = errors.New("invalid toc, please use \"true\" or \"false\"")
 
+// ParseFileContent parses a post file. Files starting with "---" have a YAML
+// front matter, the others use the legacy "key: value" header.
 func ParseFileContent(content string) (blog.Post, error) {
+	if strings.HasPrefix(content, yamlDelimiter) {
+		return parseFrontMatter(content)
+	}
+
+	if strings.HasPrefix(content, tomlDelimiter) {
+		return blog.Post{}, ErrUnsupportedFrontMatter
+	}
+
 	return newParser(content).parse()
 }
 
@@ -56,6 +70,11 @@ func (p *parser) parseHeader(header string) {
 		p.parseDescription(line)
 		p.parseImagePath(line)
 		p.parsePostTime(line)
+		p.parseTags(line)
+		p.parseSeries(line)
+		p.parseSeriesOrder(line)
+		p.parseStatus(line)
+		p.parseTOC(line)
 	}
 }
 
@@ -83,6 +102,113 @@ func (p *parser) parseImagePath(content string) {
 	}
 }
 
+func (p *parser) parseTags(content string) {
+	if tags := p.parseString(content, "tags:"); tags != "" {
+		p.post.Tags = parseTagList(tags)
+	}
+}
+
+func (p *parser) parseSeries(content string) {
+	if series := p.parseString(content, "series:"); series != "" {
+		p.post.Series = series
+	}
+}
+
+func (p *parser) parseSeriesOrder(content string) {
+	order, err := parseSeriesOrderValue(p.parseString(content, "series_order:"))
+
+	if err != nil {
+		p.err = err
+		return
+	}
+
+	if order != 0 {
+		p.post.SeriesOrder = order
+	}
+}
+
+func parseSeriesOrderValue(value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	order, err := strconv.Atoi(value)
+
+	if err != nil || order < 1 {
+		return 0, ErrInvalidSeriesOrder
+	}
+
+	return order, nil
+}
+
+func (p *parser) parseStatus(content string) {
+	status, err := parseStatusValue(p.parseString(content, "status:"))
+
+	if err != nil {
+		p.err = err
+		return
+	}
+
+	if status != "" {
+		p.post.Status = status
+	}
+}
+
+func parseStatusValue(value string) (blog.PostStatus, error) {
+	status := blog.PostStatus(value)
+
+	if status != "" && !status.IsValid() {
+		return "", ErrInvalidStatus
+	}
+
+	return status, nil
+}
+
+func (p *parser) parseTOC(content string) {
+	toc, err := parseTOCValue(p.parseString(content, "toc:"))
+
+	if err != nil {
+		p.err = err
+		return
+	}
+
+	if toc {
+		p.post.TOC = toc
+	}
+}
+
+func parseTOCValue(value string) (bool, error) {
+	if value == "" {
+		return false, nil
+	}
+
+	toc, err := strconv.ParseBool(value)
+
+	if err != nil {
+		return false, ErrInvalidTOC
+	}
+
+	return toc, nil
+}
+
+// parseTagList splits a comma separated list of tags, normalizing them so they
+// can be used in URLs, e.g. "Data Structures" becomes "data-structures".
+func parseTagList(content string) []string {
+	tags := []string{}
+	seen := map[string]bool{}
+
+	for _, tag := range strings.Split(content, ",") {
+		tag = strings.Join(strings.Fields(strings.ToLower(tag)), "-")
+
+		if tag != "" && !seen[tag] {
+			seen[tag] = true
+			tags = append(tags, tag)
+		}
+	}
+
+	return tags
+}
+
 func (p *parser) parsePostTime(content string) {
 	parsedTime, err := p.parseTime(content, "time:")
 
@@ -98,20 +224,21 @@ func (p *parser) parsePostTime(content string) {
 const timeFormat = "2006-01-02 15:04"
 
 func (p *parser) parseTime(content, field string) (time.Time, error) {
-	timeString := p.parseString(content, field)
+	return parseTimeValue(p.parseString(content, field))
+}
 
-	if timeString == "" {
+func parseTimeValue(value string) (time.Time, error) {
+	if value == "" {
 		return time.Time{}, nil
 	}
 
-	t, err := time.Parse(timeFormat, timeString)
+	t, err := time.Parse(timeFormat, value)
 
 	if err != nil {
 		return time.Time{}, ErrInvalidTime
 	}
 
 	return t, nil
-
 }
 
 func (p *parser) parseString(content, field string) string {
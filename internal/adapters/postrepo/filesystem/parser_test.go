@@ -16,6 +16,13 @@ func TestParseFileContent(t *testing.T) {
 		assertParsedContent(t, "description: Post description\n--\n", blog.Post{Description: "Post description"})
 		assertParsedContent(t, "image_path: /image.png\n--\n", blog.Post{ImagePath: "/image.png"})
 		assertParsedContent(t, "time: 2021-04-04 22:00\n--\n", blog.Post{Time: toTime("2021-04-04T22:00:00Z")})
+		assertParsedContent(t, "tags: go, testing\n--\n", blog.Post{Tags: []string{"go", "testing"}})
+		assertParsedContent(t, "series: Data Structures\n--\n", blog.Post{Series: "Data Structures"})
+		assertParsedContent(t, "series_order: 2\n--\n", blog.Post{SeriesOrder: 2})
+		assertParsedContent(t, "status: draft\n--\n", blog.Post{Status: blog.PostDraft})
+		assertParsedContent(t, "status: published\n--\n", blog.Post{Status: blog.PostPublished})
+		assertParsedContent(t, "toc: true\n--\n", blog.Post{TOC: true})
+		assertParsedContent(t, "toc: false\n--\n", blog.Post{})
 		assertParsedContent(t, ""+
 			"title: Post Title\n"+
 			"author: Author Name\n"+
@@ -32,6 +39,10 @@ func TestParseFileContent(t *testing.T) {
 			})
 	})
 
+	t.Run("It normalizes tags", func(t *testing.T) {
+		assertParsedContent(t, "tags: Go,  Data  Structures , go,,\n--\n", blog.Post{Tags: []string{"go", "data-structures"}})
+	})
+
 	t.Run("It parses content body after separator into Post Content", func(t *testing.T) {
 		assertParsedContent(t, ""+
 			"--\n"+
@@ -92,6 +103,19 @@ func TestParseFileContent(t *testing.T) {
 	t.Run("It returns error if time is in an invalid format", func(t *testing.T) {
 		assertParseError(t, "time: 04/04/2021\n--\n", filesystem.ErrInvalidTime)
 	})
+
+	t.Run("It returns error if status is unknown", func(t *testing.T) {
+		assertParseError(t, "status: archived\n--\n", filesystem.ErrInvalidStatus)
+	})
+
+	t.Run("It returns error if toc is not a boolean", func(t *testing.T) {
+		assertParseError(t, "toc: yes please\n--\n", filesystem.ErrInvalidTOC)
+	})
+
+	t.Run("It returns error if series order is not a positive integer", func(t *testing.T) {
+		assertParseError(t, "series_order: first\n--\n", filesystem.ErrInvalidSeriesOrder)
+		assertParseError(t, "series_order: 0\n--\n", filesystem.ErrInvalidSeriesOrder)
+	})
 }
 
 func assertParsedContent(t *testing.T, content string, expectedPost blog.Post) {
@@ -112,3 +136,84 @@ func toTime(s string) time.Time {
 	t, _ := time.Parse(time.RFC3339, s)
 	return t
 }
+
+func TestParseFileContentWithFrontMatter(t *testing.T) {
+	t.Run("It parses the YAML front matter into a Post", func(t *testing.T) {
+		assertParsedContent(t, ""+
+			"---\n"+
+			"title: \"Post Title: With Colon\"\n"+
+			"author: Author Name\n"+
+			"description: Description\n"+
+			"image_path: /image.png\n"+
+			"time: 2021-04-04 22:00\n"+
+			"tags: [Go, Data Structures]\n"+
+			"series: Data Structures\n"+
+			"series_order: 2\n"+
+			"status: draft\n"+
+			"toc: true\n"+
+			"---\n"+
+			"## Subtitle\n"+
+			"---\n"+
+			"Content\n",
+			blog.Post{
+				Title:       "Post Title: With Colon",
+				Author:      "Author Name",
+				Description: "Description",
+				ImagePath:   "/image.png",
+				Time:        toTime("2021-04-04T22:00:00Z"),
+				Tags:        []string{"go", "data-structures"},
+				Series:      "Data Structures",
+				SeriesOrder: 2,
+				Status:      blog.PostDraft,
+				TOC:         true,
+				Markdown:    "## Subtitle\n---\nContent\n",
+			})
+	})
+
+	t.Run("It accepts tags as a comma separated string", func(t *testing.T) {
+		assertParsedContent(t, "---\ntags: go, testing\n---\n", blog.Post{Tags: []string{"go", "testing"}})
+	})
+
+	t.Run("It keeps unknown keys in the params", func(t *testing.T) {
+		assertParsedContent(t, ""+
+			"---\n"+
+			"title: Post Title\n"+
+			"canonical_url: https://example.com/post\n"+
+			"social:\n"+
+			"  twitter: true\n"+
+			"---\n",
+			blog.Post{
+				Title: "Post Title",
+				Params: map[string]interface{}{
+					"canonical_url": "https://example.com/post",
+					"social":        map[string]interface{}{"twitter": true},
+				},
+			})
+	})
+
+	t.Run("It accepts an empty front matter", func(t *testing.T) {
+		assertParsedContent(t, "---\n---\nContent\n", blog.Post{Markdown: "Content\n"})
+	})
+
+	t.Run("It returns error when the front matter isn't closed", func(t *testing.T) {
+		assertParseError(t, "---\ntitle: Post Title\n", filesystem.ErrInvalidFormat)
+	})
+
+	t.Run("It returns error when the front matter is invalid", func(t *testing.T) {
+		post, err := filesystem.ParseFileContent("---\ntitle: [unclosed\n---\n")
+
+		assert.Equal(t, blog.Post{}, post)
+		assert.ErrorIs(t, err, filesystem.ErrInvalidFrontMatter)
+	})
+
+	t.Run("It validates the values as in the legacy header", func(t *testing.T) {
+		assertParseError(t, "---\ntime: 04/04/2021\n---\n", filesystem.ErrInvalidTime)
+		assertParseError(t, "---\nseries_order: first\n---\n", filesystem.ErrInvalidSeriesOrder)
+		assertParseError(t, "---\nstatus: archived\n---\n", filesystem.ErrInvalidStatus)
+		assertParseError(t, "---\ntoc: maybe\n---\n", filesystem.ErrInvalidTOC)
+	})
+
+	t.Run("It returns error for TOML front matter", func(t *testing.T) {
+		assertParseError(t, "+++\ntitle = \"Post Title\"\n+++\n", filesystem.ErrUnsupportedFrontMatter)
+	})
+}
@@ -21,7 +21,8 @@ func NewPostRepo(basePath string) *PostRepo {
 }
 
 func (r *PostRepo) GetPostByPath(path string) (blog.Post, error) {
-	content, err := ioutil.ReadFile(filepath.Join(r.BasePath, path+".md"))
+	file := filepath.Join(r.BasePath, path+".md")
+	content, err := ioutil.ReadFile(file)
 
 	if err != nil {
 		return blog.Post{}, blog.ErrPostNotFound
@@ -29,6 +30,12 @@ func (r *PostRepo) GetPostByPath(path string) (blog.Post, error) {
 
 	post, err := ParseFileContent(string(content))
 	post.Path = path
+	post.Metadata = blog.NewPostMetadata(post.Markdown)
+	post.Metadata.Hash = blog.ContentHash(string(content))
+
+	if info, statErr := os.Stat(file); statErr == nil && err == nil {
+		post.UpdatedAt = info.ModTime()
+	}
 
 	return post, err
 }
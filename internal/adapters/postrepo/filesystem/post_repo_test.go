@@ -1,7 +1,10 @@
 package filesystem_test
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/geisonbiazus/blog/internal/adapters/postrepo/filesystem"
 	"github.com/geisonbiazus/blog/internal/core/blog"
@@ -31,7 +34,36 @@ func TestPostRepo(t *testing.T) {
 			post, err := repo.GetPostByPath("test-post-1")
 
 			assert.Nil(t, err)
-			assert.Equal(t, testPost1, post)
+			assert.Equal(t, withFileInfo(postPath, testPost1), post)
+		})
+
+		t.Run("It sets the file modification time as the update time", func(t *testing.T) {
+			dir := t.TempDir()
+			file := filepath.Join(dir, "post.md")
+			modTime := toTime("2021-05-01T10:00:00Z")
+
+			os.WriteFile(file, []byte("title: Post\n--\n"), 0644)
+			os.Chtimes(file, modTime, modTime)
+
+			post, err := filesystem.NewPostRepo(dir).GetPostByPath("post")
+
+			assert.Nil(t, err)
+			assert.True(t, modTime.Equal(post.UpdatedAt))
+		})
+
+		t.Run("It hashes the whole content of the file", func(t *testing.T) {
+			dir := t.TempDir()
+			file := filepath.Join(dir, "post.md")
+			repo := filesystem.NewPostRepo(dir)
+
+			os.WriteFile(file, []byte("title: Post\n--\nContent\n"), 0644)
+			post, _ := repo.GetPostByPath("post")
+
+			os.WriteFile(file, []byte("title: Renamed post\n--\nContent\n"), 0644)
+			renamed, _ := repo.GetPostByPath("post")
+
+			assert.Equal(t, blog.ContentHash("title: Post\n--\nContent\n"), post.Metadata.Hash)
+			assert.NotEqual(t, post.Metadata.Hash, renamed.Metadata.Hash)
 		})
 	})
 
@@ -54,7 +86,7 @@ func TestPostRepo(t *testing.T) {
 
 		t.Run("Given a path with post files, it returns all posts sorted by descending date", func(t *testing.T) {
 			repo := filesystem.NewPostRepo(postPath)
-			expectedPosts := []blog.Post{testPost1, testPost3, testPost2}
+			expectedPosts := withFileInfoAll(postPath, testPost1, testPost3, testPost2)
 
 			actualPosts, err := repo.GetAllPosts()
 
@@ -64,7 +96,7 @@ func TestPostRepo(t *testing.T) {
 
 		t.Run("Given an invalid post in the folder, it ignores the invalid and returns the rest", func(t *testing.T) {
 			repo := filesystem.NewPostRepo(pathWithInvalidPost)
-			expectedPosts := []blog.Post{testPost1, testPost2}
+			expectedPosts := withFileInfoAll(pathWithInvalidPost, testPost1, testPost2)
 
 			actualPosts, err := repo.GetAllPosts()
 
@@ -74,7 +106,7 @@ func TestPostRepo(t *testing.T) {
 
 		t.Run("Given a path other types of files, it ignores the other files", func(t *testing.T) {
 			repo := filesystem.NewPostRepo(pathWithDifferentFiles)
-			expectedPosts := []blog.Post{testPost1}
+			expectedPosts := withFileInfoAll(pathWithDifferentFiles, testPost1)
 
 			actualPosts, err := repo.GetAllPosts()
 
@@ -84,6 +116,25 @@ func TestPostRepo(t *testing.T) {
 	})
 }
 
+func withFileInfo(basePath string, post blog.Post) blog.Post {
+	file := filepath.Join(basePath, post.Path+".md")
+	info, _ := os.Stat(file)
+	content, _ := os.ReadFile(file)
+	post.UpdatedAt = info.ModTime()
+	post.Metadata.Hash = blog.ContentHash(string(content))
+	return post
+}
+
+func withFileInfoAll(basePath string, posts ...blog.Post) []blog.Post {
+	result := []blog.Post{}
+
+	for _, post := range posts {
+		result = append(result, withFileInfo(basePath, post))
+	}
+
+	return result
+}
+
 var testPost1 = blog.Post{
 	Title:       "Test Post 1",
 	Author:      "Geison Biazus",
@@ -95,6 +146,7 @@ var testPost1 = blog.Post{
 		"## Subtitle\n" +
 		"\n" +
 		"Content\n",
+	Metadata: blog.PostMetadata{WordCount: 2, ReadingTime: time.Minute, Excerpt: "Content"},
 }
 
 var testPost2 = blog.Post{
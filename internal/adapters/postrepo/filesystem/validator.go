@@ -0,0 +1,250 @@
+package filesystem
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/geisonbiazus/blog/internal/core/blog"
+	"gopkg.in/yaml.v3"
+)
+
+// Validator checks every post in postPath, reporting all the problems found
+// instead of stopping at the first one. Images referenced by "/static/..."
+// paths are looked up in staticPath.
+type Validator struct {
+	postPath   string
+	staticPath string
+}
+
+func NewValidator(postPath, staticPath string) *Validator {
+	return &Validator{postPath: postPath, staticPath: staticPath}
+}
+
+// Validate returns the problems found in the posts. The error is only set when
+// the posts can't be read at all.
+func (v *Validator) Validate() ([]blog.Diagnostic, error) {
+	diagnostics := []blog.Diagnostic{}
+	entries, err := os.ReadDir(v.postPath)
+
+	if err != nil {
+		return diagnostics, fmt.Errorf("error on Validator.Validate when reading %s: %w", v.postPath, err)
+	}
+
+	paths := map[string]string{}
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".md") {
+			continue
+		}
+
+		file := filepath.Join(v.postPath, entry.Name())
+		content, err := os.ReadFile(file)
+
+		if err != nil {
+			return diagnostics, fmt.Errorf("error on Validator.Validate when reading %s: %w", file, err)
+		}
+
+		diagnostics = append(diagnostics, v.ValidateFileContent(file, string(content))...)
+		diagnostics = append(diagnostics, v.checkDuplicatedPath(paths, file)...)
+	}
+
+	return diagnostics, nil
+}
+
+// Paths differing only by case collide on case insensitive file systems.
+func (v *Validator) checkDuplicatedPath(paths map[string]string, file string) []blog.Diagnostic {
+	path := strings.TrimSuffix(filepath.Base(file), ".md")
+	key := strings.ToLower(path)
+
+	if other, ok := paths[key]; ok {
+		return []blog.Diagnostic{{File: file, Message: fmt.Sprintf("duplicate path \"%s\", also used by %s", path, other)}}
+	}
+
+	paths[key] = file
+	return nil
+}
+
+// ValidateFileContent returns the problems found in the content of a single
+// post file.
+func (v *Validator) ValidateFileContent(file, content string) []blog.Diagnostic {
+	check := &fileCheck{validator: v, file: file}
+
+	if strings.HasPrefix(content, yamlDelimiter) {
+		check.frontMatter(content)
+	} else if strings.HasPrefix(content, tomlDelimiter) {
+		check.report(1, ErrUnsupportedFrontMatter.Error())
+	} else {
+		check.legacyHeader(content)
+	}
+
+	return check.diagnostics
+}
+
+type fileCheck struct {
+	validator   *Validator
+	file        string
+	hasTitle    bool
+	diagnostics []blog.Diagnostic
+}
+
+func (c *fileCheck) report(line int, format string, args ...interface{}) {
+	c.diagnostics = append(c.diagnostics, blog.Diagnostic{File: c.file, Line: line, Message: fmt.Sprintf(format, args...)})
+}
+
+func (c *fileCheck) legacyHeader(content string) {
+	parts := strings.SplitN(content, "--\n", 2)
+
+	if len(parts) != 2 {
+		c.report(1, ErrInvalidFormat.Error())
+		return
+	}
+
+	for i, line := range strings.Split(parts[0], "\n") {
+		c.legacyHeaderLine(i+1, line)
+	}
+
+	c.checkTitle()
+}
+
+func (c *fileCheck) legacyHeaderLine(number int, line string) {
+	if strings.TrimSpace(line) == "" {
+		return
+	}
+
+	separator := strings.Index(line, ":")
+
+	if separator < 0 {
+		c.report(number, "invalid header line \"%s\", please use \"key: value\"", line)
+		return
+	}
+
+	key := line[:separator]
+
+	if !isFrontMatterKey(key) {
+		c.report(number, "unknown header key \"%s\"", key)
+		return
+	}
+
+	c.value(number, key, strings.Trim(line[separator+1:], " \n"))
+}
+
+func (c *fileCheck) frontMatter(content string) {
+	header, _, err := splitFrontMatter(content)
+
+	if err != nil {
+		c.report(1, "front matter is not closed, please end it with \"---\"")
+		return
+	}
+
+	var document yaml.Node
+
+	if err := yaml.Unmarshal([]byte(header), &document); err != nil {
+		c.report(yamlErrorLine(err), "%s: %v", ErrInvalidFrontMatter, err)
+		return
+	}
+
+	if len(document.Content) > 0 {
+		c.frontMatterMapping(document.Content[0])
+	}
+
+	c.checkTitle()
+}
+
+// The front matter starts after the "---" delimiter, so YAML lines are
+// shifted by one in the file.
+func (c *fileCheck) frontMatterMapping(mapping *yaml.Node) {
+	if mapping.Kind != yaml.MappingNode {
+		c.report(mapping.Line+1, "front matter must be a mapping of keys to values")
+		return
+	}
+
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		key, value := mapping.Content[i], mapping.Content[i+1]
+
+		if !isFrontMatterKey(key.Value) {
+			continue
+		}
+
+		if key.Value == "tags" {
+			if value.Kind != yaml.ScalarNode && value.Kind != yaml.SequenceNode {
+				c.report(key.Line+1, "\"tags\" must be a list or a comma separated string")
+			}
+			continue
+		}
+
+		if value.Kind != yaml.ScalarNode {
+			c.report(key.Line+1, "\"%s\" must be a single value", key.Value)
+			continue
+		}
+
+		c.value(key.Line+1, key.Value, value.Value)
+	}
+}
+
+func (c *fileCheck) value(line int, key, value string) {
+	var err error
+
+	switch key {
+	case "title":
+		c.hasTitle = value != ""
+	case "time":
+		_, err = parseTimeValue(value)
+	case "series_order":
+		_, err = parseSeriesOrderValue(value)
+	case "status":
+		_, err = parseStatusValue(value)
+	case "toc":
+		_, err = parseTOCValue(value)
+	case "image_path":
+		c.imagePath(line, value)
+	}
+
+	if err != nil {
+		c.report(line, err.Error())
+	}
+}
+
+func (c *fileCheck) imagePath(line int, value string) {
+	if value == "" || strings.HasPrefix(value, "http://") || strings.HasPrefix(value, "https://") {
+		return
+	}
+
+	if !strings.HasPrefix(value, "/static/") {
+		c.report(line, "image \"%s\" must be served from /static/ or be an absolute URL", value)
+		return
+	}
+
+	path := filepath.Join(c.validator.staticPath, filepath.FromSlash(strings.TrimPrefix(value, "/static/")))
+
+	if info, err := os.Stat(path); err != nil || info.IsDir() {
+		c.report(line, "image \"%s\" not found in %s", value, c.validator.staticPath)
+	}
+}
+
+func (c *fileCheck) checkTitle() {
+	if !c.hasTitle {
+		c.report(1, "missing title")
+	}
+}
+
+func isFrontMatterKey(key string) bool {
+	for _, k := range frontMatterKeys {
+		if k == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+func yamlErrorLine(err error) int {
+	var line int
+
+	if _, scanErr := fmt.Sscanf(err.Error(), "yaml: line %d:", &line); scanErr != nil {
+		return 1
+	}
+
+	return line + 1
+}
@@ -0,0 +1,81 @@
+package filesystem_test
+
+import (
+	"testing"
+
+	"github.com/geisonbiazus/blog/internal/adapters/postrepo/filesystem"
+	"github.com/geisonbiazus/blog/internal/core/blog"
+	"github.com/stretchr/testify/assert"
+)
+
+const postsToValidatePath = "fixtures/posts_to_validate"
+const staticPath = "fixtures/static"
+
+func TestValidator(t *testing.T) {
+	t.Run("It reports every problem with file and line", func(t *testing.T) {
+		validator := filesystem.NewValidator(postsToValidatePath, staticPath)
+
+		diagnostics, err := validator.Validate()
+
+		assert.Nil(t, err)
+		assert.Equal(t, []string{
+			"fixtures/posts_to_validate/invalid-legacy-post.md:2: image \"/static/image/missing.png\" not found in fixtures/static",
+			"fixtures/posts_to_validate/invalid-legacy-post.md:3: " + filesystem.ErrInvalidTime.Error(),
+			"fixtures/posts_to_validate/invalid-legacy-post.md:4: unknown header key \"subtitle\"",
+			"fixtures/posts_to_validate/invalid-legacy-post.md:5: " + filesystem.ErrInvalidStatus.Error(),
+			"fixtures/posts_to_validate/invalid-legacy-post.md:1: missing title",
+			"fixtures/posts_to_validate/invalid-yaml-post.md:3: " + filesystem.ErrInvalidSeriesOrder.Error(),
+			"fixtures/posts_to_validate/invalid-yaml-post.md:4: \"tags\" must be a list or a comma separated string",
+			"fixtures/posts_to_validate/valid-post.md: duplicate path \"valid-post\", also used by fixtures/posts_to_validate/Valid-Post.md",
+		}, toStrings(diagnostics))
+	})
+
+	t.Run("It returns error when the posts can't be read", func(t *testing.T) {
+		validator := filesystem.NewValidator(invalidPath, staticPath)
+
+		diagnostics, err := validator.Validate()
+
+		assert.Empty(t, diagnostics)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("It validates the content of a single file", func(t *testing.T) {
+		validator := filesystem.NewValidator(postsToValidatePath, staticPath)
+
+		assert.Empty(t, validator.ValidateFileContent("post.md", "title: Title\n--\n"))
+		assert.Empty(t, validator.ValidateFileContent("post.md", "---\ntitle: Title\nimage_path: https://example.com/a.png\n---\n"))
+		assert.Equal(t, []blog.Diagnostic{
+			{File: "post.md", Line: 1, Message: filesystem.ErrInvalidFormat.Error()},
+		}, validator.ValidateFileContent("post.md", "title: Title\n"))
+		assert.Equal(t, []blog.Diagnostic{
+			{File: "post.md", Line: 1, Message: "front matter is not closed, please end it with \"---\""},
+		}, validator.ValidateFileContent("post.md", "---\ntitle: Title\n"))
+		assert.Equal(t, []blog.Diagnostic{
+			{File: "post.md", Line: 3, Message: filesystem.ErrInvalidTOC.Error()},
+		}, validator.ValidateFileContent("post.md", "---\ntitle: Title\ntoc: maybe\n---\n"))
+		assert.Equal(t, []blog.Diagnostic{
+			{File: "post.md", Line: 2, Message: "image \"/image.png\" must be served from /static/ or be an absolute URL"},
+		}, validator.ValidateFileContent("post.md", "title: Title\nimage_path: /image.png\n--\n"))
+	})
+
+	t.Run("It reports the line of YAML syntax errors", func(t *testing.T) {
+		validator := filesystem.NewValidator(postsToValidatePath, staticPath)
+
+		diagnostics := validator.ValidateFileContent("post.md", "---\ntitle: Title\nauthor: a: b\n---\n")
+
+		assert.Len(t, diagnostics, 1)
+		assert.Equal(t, 3, diagnostics[0].Line)
+		assert.Contains(t, diagnostics[0].Message, filesystem.ErrInvalidFrontMatter.Error())
+	})
+
+}
+
+func toStrings(diagnostics []blog.Diagnostic) []string {
+	result := []string{}
+
+	for _, d := range diagnostics {
+		result = append(result, d.String())
+	}
+
+	return result
+}
@@ -5,3 +5,11 @@ import "github.com/geisonbiazus/blog/internal/adapters/postrepo/filesystem"
 func NewFileSystemPostRepo(basePath string) *filesystem.PostRepo {
 	return filesystem.NewPostRepo(basePath)
 }
+
+func NewFileSystemPostValidator(postPath, staticPath string) *filesystem.Validator {
+	return filesystem.NewValidator(postPath, staticPath)
+}
+
+func NewFileSystemPostFormatter() *filesystem.Formatter {
+	return filesystem.NewFormatter()
+}
@@ -0,0 +1,121 @@
+package goldmark
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/alecthomas/chroma/formatters/html"
+	"github.com/yuin/goldmark"
+	highlighting "github.com/yuin/goldmark-highlighting"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// CommentRenderer renders untrusted markdown submitted by readers. Raw HTML is
+// omitted, heading IDs are not generated and only http, https, mailto and
+// relative URLs are kept.
+type CommentRenderer struct {
+	markdown goldmark.Markdown
+}
+
+func NewCommentRenderer() *CommentRenderer {
+	return &CommentRenderer{
+		markdown: goldmark.New(
+			goldmark.WithExtensions(
+				extension.GFM,
+				highlighting.NewHighlighting(
+					highlighting.WithStyle("monokai"),
+					highlighting.WithFormatOptions(
+						html.TabWidth(2),
+					),
+				),
+			),
+			goldmark.WithParserOptions(
+				parser.WithASTTransformers(
+					util.Prioritized(&safeLinkTransformer{}, 999),
+				),
+			),
+		),
+	}
+}
+
+func (r *CommentRenderer) Render(content string) (string, error) {
+	var buf bytes.Buffer
+
+	err := r.markdown.Convert([]byte(content), &buf)
+
+	if err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+const commentLinkRel = "nofollow ugc noopener"
+
+type safeLinkTransformer struct{}
+
+func (t *safeLinkTransformer) Transform(doc *ast.Document, reader text.Reader, pc parser.Context) {
+	source := reader.Source()
+	unsafeAutoLinks := []*ast.AutoLink{}
+
+	ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		switch n := node.(type) {
+		case *ast.Link:
+			if !isSafeURL(n.Destination) {
+				n.Destination = []byte{}
+			}
+			n.SetAttributeString("rel", []byte(commentLinkRel))
+		case *ast.Image:
+			if !isSafeURL(n.Destination) {
+				n.Destination = []byte{}
+			}
+		case *ast.AutoLink:
+			if n.AutoLinkType == ast.AutoLinkURL && !isSafeURL(n.URL(source)) {
+				unsafeAutoLinks = append(unsafeAutoLinks, n)
+			} else {
+				n.SetAttributeString("rel", []byte(commentLinkRel))
+			}
+		}
+
+		return ast.WalkContinue, nil
+	})
+
+	for _, link := range unsafeAutoLinks {
+		parent := link.Parent()
+		parent.ReplaceChild(parent, link, ast.NewString(link.Label(source)))
+	}
+}
+
+var safeURLSchemes = map[string]bool{
+	"http":   true,
+	"https":  true,
+	"mailto": true,
+}
+
+func isSafeURL(url []byte) bool {
+	// Browsers ignore whitespace and control characters inside the scheme, so
+	// they are stripped before checking it.
+	cleaned := strings.Map(func(r rune) rune {
+		if r <= ' ' || r == 0x7f {
+			return -1
+		}
+		return r
+	}, string(url))
+
+	cleaned = strings.ToLower(cleaned)
+	i := strings.IndexAny(cleaned, ":/?#")
+
+	if i == -1 || cleaned[i] != ':' {
+		return true
+	}
+
+	return safeURLSchemes[cleaned[:i]]
+}
@@ -0,0 +1,62 @@
+package goldmark_test
+
+import (
+	"testing"
+
+	"github.com/geisonbiazus/blog/internal/adapters/renderer/goldmark"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGoldmarkCommentRenderer(t *testing.T) {
+	render := func(content string) string {
+		html, err := goldmark.NewCommentRenderer().Render(content)
+		assert.Nil(t, err)
+		return html
+	}
+
+	t.Run("Given a markdown string, it converts to HTML", func(t *testing.T) {
+		assert.Equal(t, "<p><strong>Hello</strong> world</p>\n", render("**Hello** world"))
+	})
+
+	t.Run("It omits raw HTML", func(t *testing.T) {
+		html := render("Hi <script>alert(1)</script>\n\n<div onclick=\"alert(1)\">div</div>")
+
+		assert.NotContains(t, html, "<script>")
+		assert.NotContains(t, html, "onclick")
+		assert.Contains(t, html, "<!-- raw HTML omitted -->")
+	})
+
+	t.Run("It doesn't generate heading IDs", func(t *testing.T) {
+		assert.Equal(t, "<h1>Title</h1>\n", render("# Title"))
+	})
+
+	t.Run("It adds rel attributes to links", func(t *testing.T) {
+		assert.Equal(t,
+			`<p><a href="http://example.com" rel="nofollow ugc noopener">Link</a></p>`+"\n",
+			render("[Link](http://example.com)"),
+		)
+		assert.Equal(t,
+			`<p><a href="https://example.com" rel="nofollow ugc noopener">https://example.com</a></p>`+"\n",
+			render("https://example.com"),
+		)
+	})
+
+	t.Run("It removes javascript URLs from links and images", func(t *testing.T) {
+		assert.Equal(t,
+			`<p><a href="" rel="nofollow ugc noopener">a</a> <a href="" rel="nofollow ugc noopener">b</a></p>`+"\n",
+			render("[a](javascript:alert(1)) [b](JaVaScRiPt:alert(1))"),
+		)
+		assert.Equal(t, `<p><img src="" alt="i"></p>`+"\n", render("![i](javascript:alert(1))"))
+	})
+
+	t.Run("It renders javascript autolinks as plain text", func(t *testing.T) {
+		assert.Equal(t, "<p>javascript:alert(1)</p>\n", render("<javascript:alert(1)>"))
+	})
+
+	t.Run("It keeps relative and mailto links", func(t *testing.T) {
+		assert.Equal(t,
+			`<p><a href="/posts/post" rel="nofollow ugc noopener">a</a> <a href="mailto:user@example.com" rel="nofollow ugc noopener">b</a></p>`+"\n",
+			render("[a](/posts/post) [b](mailto:user@example.com)"),
+		)
+	})
+}
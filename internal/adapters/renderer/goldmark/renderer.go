@@ -4,11 +4,15 @@ import (
 	"bytes"
 
 	"github.com/alecthomas/chroma/formatters/html"
+	"github.com/geisonbiazus/blog/internal/core/blog"
 	"github.com/yuin/goldmark"
 	highlighting "github.com/yuin/goldmark-highlighting"
+	"github.com/yuin/goldmark/ast"
 	"github.com/yuin/goldmark/extension"
 	"github.com/yuin/goldmark/parser"
 	htmloptions "github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
 )
 
 type Renderer struct{}
@@ -17,7 +21,7 @@ func NewRenderer() *Renderer {
 	return &Renderer{}
 }
 
-func (r *Renderer) Render(content string) (string, error) {
+func (r *Renderer) Render(content string) (string, []blog.Heading, error) {
 	var buf bytes.Buffer
 
 	markdown := goldmark.New(
@@ -32,17 +36,100 @@ func (r *Renderer) Render(content string) (string, error) {
 		),
 		goldmark.WithParserOptions(
 			parser.WithAutoHeadingID(),
+			parser.WithASTTransformers(
+				util.Prioritized(&headingTransformer{}, 999),
+			),
 		),
 		goldmark.WithRendererOptions(
 			htmloptions.WithUnsafe(),
 		),
 	)
 
-	err := markdown.Convert([]byte(content), &buf)
+	ctx := parser.NewContext()
+	err := markdown.Convert([]byte(content), &buf, parser.WithContext(ctx))
 
 	if err != nil {
-		return "", err
+		return "", nil, err
+	}
+
+	headings, _ := ctx.Get(headingsKey).([]blog.Heading)
+
+	return buf.String(), headings, nil
+}
+
+var headingsKey = parser.NewContextKey()
+
+const headingAnchorClass = "heading-anchor"
+
+// headingTransformer collects the headings into a tree stored in the parser
+// context and appends a link to itself to each heading so readers can share
+// a section.
+type headingTransformer struct{}
+
+func (t *headingTransformer) Transform(doc *ast.Document, reader text.Reader, pc parser.Context) {
+	source := reader.Source()
+	headings := []*ast.Heading{}
+
+	ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if heading, ok := node.(*ast.Heading); ok && entering {
+			headings = append(headings, heading)
+			return ast.WalkSkipChildren, nil
+		}
+
+		return ast.WalkContinue, nil
+	})
+
+	flat := []blog.Heading{}
+
+	for _, heading := range headings {
+		id := headingID(heading)
+		flat = append(flat, blog.Heading{Level: heading.Level, Text: string(heading.Text(source)), ID: id})
+
+		if id != "" {
+			heading.AppendChild(heading, newHeadingAnchor(id))
+		}
+	}
+
+	pc.Set(headingsKey, buildHeadingTree(flat))
+}
+
+func headingID(heading *ast.Heading) string {
+	id, ok := heading.AttributeString("id")
+
+	if !ok {
+		return ""
+	}
+
+	value, _ := id.([]byte)
+	return string(value)
+}
+
+func newHeadingAnchor(id string) *ast.Link {
+	link := ast.NewLink()
+	link.Destination = []byte("#" + id)
+	link.Title = []byte("Link to this section")
+	link.SetAttributeString("class", []byte(headingAnchorClass))
+	link.AppendChild(link, ast.NewString([]byte("#")))
+	return link
+}
+
+// buildHeadingTree nests each heading under the closest previous heading of a
+// lower level.
+func buildHeadingTree(headings []blog.Heading) []blog.Heading {
+	var tree []blog.Heading
+
+	for len(headings) > 0 {
+		heading := headings[0]
+		end := 1
+
+		for end < len(headings) && headings[end].Level > heading.Level {
+			end++
+		}
+
+		heading.Children = buildHeadingTree(headings[1:end])
+		tree = append(tree, heading)
+		headings = headings[end:]
 	}
 
-	return buf.String(), nil
+	return tree
 }
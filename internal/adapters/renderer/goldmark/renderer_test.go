@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/geisonbiazus/blog/internal/adapters/renderer/goldmark"
+	"github.com/geisonbiazus/blog/internal/core/blog"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -11,7 +12,7 @@ func TestGoldmarkRenderer(t *testing.T) {
 	t.Run("Given a markdown string, it converts to HTML", func(t *testing.T) {
 		rend := goldmark.NewRenderer()
 
-		html, err := rend.Render(sampleMarkdown)
+		html, _, err := rend.Render(sampleMarkdown)
 		assert.Equal(t, sampleHTML, html)
 		assert.Nil(t, err)
 	})
@@ -19,10 +20,36 @@ func TestGoldmarkRenderer(t *testing.T) {
 	t.Run("Given a code block, it highlights the syntax", func(t *testing.T) {
 		rend := goldmark.NewRenderer()
 
-		html, err := rend.Render(codeMarkdown)
+		html, _, err := rend.Render(codeMarkdown)
 		assert.Equal(t, highlightedCodeHTML, html)
 		assert.Nil(t, err)
 	})
+
+	t.Run("It returns the headings as a tree", func(t *testing.T) {
+		rend := goldmark.NewRenderer()
+
+		_, headings, err := rend.Render("# A `b`\n\n## C\n\n### D\n\n## E\n\n# F\n")
+
+		assert.Nil(t, err)
+		assert.Equal(t, []blog.Heading{
+			{Level: 1, Text: "A b", ID: "a-b", Children: []blog.Heading{
+				{Level: 2, Text: "C", ID: "c", Children: []blog.Heading{
+					{Level: 3, Text: "D", ID: "d"},
+				}},
+				{Level: 2, Text: "E", ID: "e"},
+			}},
+			{Level: 1, Text: "F", ID: "f"},
+		}, headings)
+	})
+
+	t.Run("Given no headings, it returns no headings", func(t *testing.T) {
+		rend := goldmark.NewRenderer()
+
+		_, headings, err := rend.Render("Just a paragraph")
+
+		assert.Nil(t, err)
+		assert.Empty(t, headings)
+	})
 }
 
 const sampleMarkdown = `# Title 1
@@ -64,10 +91,10 @@ Table:
 Code Block
 ` + "```"
 
-const sampleHTML = `<h1 id="title-1">Title 1</h1>
-<h2 id="title-2">Title 2</h2>
-<h3 id="title-3">Title 3</h3>
-<h4 id="title-4">Title 4</h4>
+const sampleHTML = `<h1 id="title-1">Title 1<a href="#title-1" title="Link to this section" class="heading-anchor">#</a></h1>
+<h2 id="title-2">Title 2<a href="#title-2" title="Link to this section" class="heading-anchor">#</a></h2>
+<h3 id="title-3">Title 3<a href="#title-3" title="Link to this section" class="heading-anchor">#</a></h3>
+<h4 id="title-4">Title 4<a href="#title-4" title="Link to this section" class="heading-anchor">#</a></h4>
 <p>This is a paragraph</p>
 <p>This is a paragraph.<br>
 But now with a line break in the middle.</p>
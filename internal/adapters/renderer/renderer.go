@@ -5,3 +5,7 @@ import "github.com/geisonbiazus/blog/internal/adapters/renderer/goldmark"
 func NewGoldmarkRenderer() *goldmark.Renderer {
 	return goldmark.NewRenderer()
 }
+
+func NewGoldmarkCommentRenderer() *goldmark.CommentRenderer {
+	return goldmark.NewCommentRenderer()
+}
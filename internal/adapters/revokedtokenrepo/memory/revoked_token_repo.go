@@ -0,0 +1,35 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/geisonbiazus/blog/internal/core/auth"
+)
+
+type RevokedTokenRepo struct {
+	mu     sync.RWMutex
+	tokens map[string]auth.Token
+}
+
+func NewRevokedTokenRepo() *RevokedTokenRepo {
+	return &RevokedTokenRepo{
+		tokens: make(map[string]auth.Token),
+	}
+}
+
+func (r *RevokedTokenRepo) RevokeToken(ctx context.Context, token auth.Token) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.tokens[token.ID] = token
+	return nil
+}
+
+func (r *RevokedTokenRepo) IsTokenRevoked(ctx context.Context, tokenID string) (bool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	_, ok := r.tokens[tokenID]
+	return ok, nil
+}
@@ -0,0 +1,54 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/geisonbiazus/blog/internal/core/auth"
+	"github.com/geisonbiazus/blog/pkg/dbrepo"
+)
+
+type RevokedTokenRepo struct {
+	*dbrepo.Base
+}
+
+func NewRevokedTokenRepo(db *sql.DB) *RevokedTokenRepo {
+	return &RevokedTokenRepo{Base: dbrepo.NewBase(db)}
+}
+
+func (r *RevokedTokenRepo) RevokeToken(ctx context.Context, token auth.Token) error {
+	_, err := r.Exec(ctx, `
+		INSERT INTO auth_revoked_tokens
+			(id, user_id, expires_at)
+		VALUES
+			($1, $2, $3)
+		ON CONFLICT (id) DO NOTHING`,
+		token.ID, token.UserID, token.ExpiresAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("error on RevokeToken: %w", err)
+	}
+
+	return nil
+}
+
+func (r *RevokedTokenRepo) IsTokenRevoked(ctx context.Context, tokenID string) (bool, error) {
+	conn := r.Conn(ctx)
+
+	row := conn.QueryRowContext(ctx, `
+		SELECT EXISTS(SELECT 1 FROM auth_revoked_tokens WHERE id = $1)`,
+		tokenID,
+	)
+
+	var revoked bool
+
+	err := row.Scan(&revoked)
+
+	if err != nil {
+		return false, fmt.Errorf("error on IsTokenRevoked when executing query: %w", err)
+	}
+
+	return revoked, nil
+}
@@ -0,0 +1,64 @@
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/geisonbiazus/blog/internal/adapters/idgenerator/uuid"
+	"github.com/geisonbiazus/blog/internal/adapters/revokedtokenrepo/postgres"
+	"github.com/geisonbiazus/blog/internal/core/auth"
+	"github.com/geisonbiazus/blog/pkg/dbrepo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRevokedTokenRepo(t *testing.T) {
+	uuidGen := uuid.NewGenerator()
+
+	newToken := func() auth.Token {
+		return auth.Token{
+			ID:        uuidGen.Generate(),
+			UserID:    uuidGen.Generate(),
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+	}
+
+	t.Run("It returns false when the token was not revoked", func(t *testing.T) {
+		dbrepo.Test(func(ctx context.Context, db *sql.DB) {
+			repo := postgres.NewRevokedTokenRepo(db)
+
+			revoked, err := repo.IsTokenRevoked(ctx, newToken().ID)
+
+			assert.Nil(t, err)
+			assert.False(t, revoked)
+		})
+	})
+
+	t.Run("It returns true when the token was revoked", func(t *testing.T) {
+		dbrepo.Test(func(ctx context.Context, db *sql.DB) {
+			repo := postgres.NewRevokedTokenRepo(db)
+			token := newToken()
+
+			err := repo.RevokeToken(ctx, token)
+			assert.Nil(t, err)
+
+			revoked, err := repo.IsTokenRevoked(ctx, token.ID)
+
+			assert.Nil(t, err)
+			assert.True(t, revoked)
+		})
+	})
+
+	t.Run("It ignores a token revoked twice", func(t *testing.T) {
+		dbrepo.Test(func(ctx context.Context, db *sql.DB) {
+			repo := postgres.NewRevokedTokenRepo(db)
+			token := newToken()
+
+			repo.RevokeToken(ctx, token)
+			err := repo.RevokeToken(ctx, token)
+
+			assert.Nil(t, err)
+		})
+	})
+}
@@ -0,0 +1,16 @@
+package revokedtokenrepo
+
+import (
+	"database/sql"
+
+	"github.com/geisonbiazus/blog/internal/adapters/revokedtokenrepo/memory"
+	"github.com/geisonbiazus/blog/internal/adapters/revokedtokenrepo/postgres"
+)
+
+func NewMemoryRevokedTokenRepo() *memory.RevokedTokenRepo {
+	return memory.NewRevokedTokenRepo()
+}
+
+func NewPostgresRevokedTokenRepo(db *sql.DB) *postgres.RevokedTokenRepo {
+	return postgres.NewRevokedTokenRepo(db)
+}
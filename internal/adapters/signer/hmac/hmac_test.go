@@ -0,0 +1,33 @@
+package hmac_test
+
+import (
+	"testing"
+
+	"github.com/geisonbiazus/blog/internal/adapters/signer/hmac"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSigner(t *testing.T) {
+	t.Run("It verifies signatures it generated", func(t *testing.T) {
+		signer := hmac.NewSigner("secret")
+
+		signature := signer.Sign("value")
+
+		assert.True(t, signer.Verify("value", signature))
+	})
+
+	t.Run("It rejects signatures for other values or secrets", func(t *testing.T) {
+		signer := hmac.NewSigner("secret")
+		otherSigner := hmac.NewSigner("other secret")
+
+		assert.False(t, signer.Verify("other value", signer.Sign("value")))
+		assert.False(t, signer.Verify("value", otherSigner.Sign("value")))
+		assert.False(t, signer.Verify("value", "not base64!"))
+	})
+
+	t.Run("It rejects every signature when no secret is configured", func(t *testing.T) {
+		signer := hmac.NewSigner("")
+
+		assert.False(t, signer.Verify("value", signer.Sign("value")))
+	})
+}
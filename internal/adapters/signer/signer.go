@@ -0,0 +1,7 @@
+package signer
+
+import "github.com/geisonbiazus/blog/internal/adapters/signer/hmac"
+
+func NewHMACSigner(secret string) *hmac.Signer {
+	return hmac.NewSigner(secret)
+}
@@ -0,0 +1,39 @@
+package post
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/geisonbiazus/blog/internal/core/blog"
+)
+
+// SubjectChecker opens the published posts for comments, identified by their
+// path. Drafts, scheduled posts and unknown paths are closed.
+type SubjectChecker struct {
+	postRepo blog.PostRepo
+}
+
+func NewSubjectChecker(postRepo blog.PostRepo) *SubjectChecker {
+	return &SubjectChecker{postRepo: postRepo}
+}
+
+func (c *SubjectChecker) IsOpenForComments(ctx context.Context, subjectID string) (bool, error) {
+	if subjectID == "" || subjectID != path.Base(subjectID) {
+		return false, nil
+	}
+
+	post, err := c.postRepo.GetPostByPath(subjectID)
+
+	if errors.Is(err, blog.ErrPostNotFound) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("error on IsOpenForComments when finding post: %w", err)
+	}
+
+	return post.IsPublished(time.Now()), nil
+}
@@ -0,0 +1,69 @@
+package post_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/geisonbiazus/blog/internal/adapters/subjectchecker/post"
+	"github.com/geisonbiazus/blog/internal/core/blog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubjectChecker(t *testing.T) {
+	ctx := context.Background()
+	published := blog.Post{Path: "published", Time: time.Now().Add(-time.Hour)}
+	draft := blog.Post{Path: "draft", Time: time.Now().Add(-time.Hour), Status: blog.PostDraft}
+	scheduled := blog.Post{Path: "scheduled", Time: time.Now().Add(time.Hour)}
+
+	checker := post.NewSubjectChecker(&postRepoStub{posts: []blog.Post{published, draft, scheduled}})
+
+	t.Run("It opens published posts for comments", func(t *testing.T) {
+		open, err := checker.IsOpenForComments(ctx, "published")
+
+		assert.True(t, open)
+		assert.Nil(t, err)
+	})
+
+	t.Run("It closes drafts, scheduled posts and unknown paths", func(t *testing.T) {
+		for _, subjectID := range []string{"draft", "scheduled", "unknown", "", "../published"} {
+			open, err := checker.IsOpenForComments(ctx, subjectID)
+
+			assert.False(t, open, subjectID)
+			assert.Nil(t, err)
+		}
+	})
+
+	t.Run("It returns unrecognized errors", func(t *testing.T) {
+		repoErr := errors.New("repo error")
+		checker := post.NewSubjectChecker(&postRepoStub{err: repoErr})
+
+		_, err := checker.IsOpenForComments(ctx, "published")
+
+		assert.ErrorIs(t, err, repoErr)
+	})
+}
+
+type postRepoStub struct {
+	posts []blog.Post
+	err   error
+}
+
+func (r *postRepoStub) GetPostByPath(path string) (blog.Post, error) {
+	if r.err != nil {
+		return blog.Post{}, r.err
+	}
+
+	for _, post := range r.posts {
+		if post.Path == path {
+			return post, nil
+		}
+	}
+
+	return blog.Post{}, blog.ErrPostNotFound
+}
+
+func (r *postRepoStub) GetAllPosts() ([]blog.Post, error) {
+	return r.posts, r.err
+}
@@ -0,0 +1,10 @@
+package subjectchecker
+
+import (
+	"github.com/geisonbiazus/blog/internal/adapters/subjectchecker/post"
+	"github.com/geisonbiazus/blog/internal/core/blog"
+)
+
+func NewPostSubjectChecker(postRepo blog.PostRepo) *post.SubjectChecker {
+	return post.NewSubjectChecker(postRepo)
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/dgrijalva/jwt-go"
 	"github.com/geisonbiazus/blog/internal/core/auth"
+	"github.com/google/uuid"
 )
 
 type TokenEncoder struct {
@@ -30,7 +31,7 @@ func (m *TokenEncoder) Encode(value string, expiresIn time.Duration) (string, er
 	return signedToken, nil
 }
 
-func (m *TokenEncoder) Decode(token string) (string, error) {
+func (m *TokenEncoder) Decode(token string) (auth.Token, error) {
 	t, err := jwt.ParseWithClaims(token, &jwtClaims{}, func(t *jwt.Token) (interface{}, error) {
 		if t.Method.Alg() != jwt.SigningMethodHS512.Alg() {
 			return nil, ErrInvalidSigningAlgorithm
@@ -40,12 +41,16 @@ func (m *TokenEncoder) Decode(token string) (string, error) {
 	})
 
 	if err != nil {
-		return "", m.handleDecodingError(err)
+		return auth.Token{}, m.handleDecodingError(err)
 	}
 
 	claims := t.Claims.(*jwtClaims)
 
-	return claims.Subject, nil
+	return auth.Token{
+		ID:        claims.Id,
+		UserID:    claims.Subject,
+		ExpiresAt: time.Unix(claims.ExpiresAt, 0),
+	}, nil
 }
 
 func (m *TokenEncoder) handleDecodingError(err error) error {
@@ -65,6 +70,7 @@ type jwtClaims struct {
 func newClaims(sub string, expiresIn time.Duration) *jwtClaims {
 	return &jwtClaims{
 		StandardClaims: jwt.StandardClaims{
+			Id:        uuid.New().String(),
 			Subject:   sub,
 			ExpiresAt: expiresAt(expiresIn),
 		},
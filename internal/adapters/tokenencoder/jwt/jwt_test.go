@@ -19,10 +19,24 @@ func TestTokenEncoder(t *testing.T) {
 
 		assert.Nil(t, err)
 
-		decodedValue, err := manager.Decode(token)
+		decodedToken, err := manager.Decode(token)
 
 		assert.Nil(t, err)
-		assert.Equal(t, value, decodedValue)
+		assert.Equal(t, value, decodedToken.UserID)
+		assert.NotEmpty(t, decodedToken.ID)
+		assert.WithinDuration(t, time.Now().Add(10*time.Minute), decodedToken.ExpiresAt, 2*time.Second)
+	})
+
+	t.Run("It generates a unique ID for each token", func(t *testing.T) {
+		manager := jwt.NewTokenEncoder("secret")
+
+		token1, _ := manager.Encode("value", 10*time.Minute)
+		token2, _ := manager.Encode("value", 10*time.Minute)
+
+		decodedToken1, _ := manager.Decode(token1)
+		decodedToken2, _ := manager.Decode(token2)
+
+		assert.NotEqual(t, decodedToken1.ID, decodedToken2.ID)
 	})
 
 	t.Run("It returns error when decoding expired token", func(t *testing.T) {
@@ -34,9 +48,9 @@ func TestTokenEncoder(t *testing.T) {
 
 		assert.Nil(t, err)
 
-		decodedValue, err := manager.Decode(token)
+		decodedToken, err := manager.Decode(token)
 
 		assert.Error(t, auth.ErrTokenExpired, err)
-		assert.Equal(t, "", decodedValue)
+		assert.Equal(t, auth.Token{}, decodedToken)
 	})
 }
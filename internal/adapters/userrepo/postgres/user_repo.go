@@ -25,6 +25,7 @@ func (r *UserRepo) CreateUser(ctx context.Context, user auth.User) error {
 		"email":            user.Email,
 		"provider_user_id": user.ProviderUserID,
 		"avatar_url":       user.AvatarURL,
+		"role":             user.Role,
 	})
 
 	if err != nil {
@@ -40,6 +41,7 @@ func (r *UserRepo) UpdateUser(ctx context.Context, user auth.User) error {
 		"email":            user.Email,
 		"provider_user_id": user.ProviderUserID,
 		"avatar_url":       user.AvatarURL,
+		"role":             user.Role,
 	})
 
 	if err != nil {
@@ -66,7 +68,7 @@ func (r *UserRepo) findUserBy(ctx context.Context, field string, value interface
 
 	row := conn.QueryRowContext(ctx, `
 		SELECT 
-			id, name, email, provider_user_id, avatar_url 
+			id, name, email, provider_user_id, avatar_url, role
 		FROM auth_users 
 		WHERE `+field+` = $1`,
 		value,
@@ -74,7 +76,7 @@ func (r *UserRepo) findUserBy(ctx context.Context, field string, value interface
 
 	user := auth.User{}
 
-	err := row.Scan(&user.ID, &user.Name, &user.Email, &user.ProviderUserID, &user.AvatarURL)
+	err := row.Scan(&user.ID, &user.Name, &user.Email, &user.ProviderUserID, &user.AvatarURL, &user.Role)
 
 	if errors.Is(err, sql.ErrNoRows) {
 		return auth.User{}, auth.ErrUserNotFound
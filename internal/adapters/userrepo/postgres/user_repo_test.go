@@ -29,6 +29,7 @@ func TestUserRepo(t *testing.T) {
 			Email:          "user@example.com",
 			ProviderUserID: "provider_user_id",
 			AvatarURL:      "http://example.com/avatar",
+			Role:           auth.RoleCommenter,
 		}
 
 		return &testUserRepoFixture{
@@ -78,6 +79,7 @@ func TestUserRepo(t *testing.T) {
 				user.Email = "new-email@example.com"
 				user.Name = "new name"
 				user.ProviderUserID = "new_provider_user_id"
+				user.Role = auth.RoleAdmin
 
 				err := f.repo.UpdateUser(ctx, user)
 
@@ -0,0 +1,102 @@
+package polling
+
+import (
+	"io/fs"
+	"path/filepath"
+	"time"
+)
+
+// Watcher detects changes in the files under the given paths by comparing
+// their modification time and size on every interval. Polling works the same
+// on every platform and inside mounted volumes, where file system events are
+// not always delivered.
+type Watcher struct {
+	paths    []string
+	interval time.Duration
+	snapshot map[string]fileState
+	stop     chan struct{}
+}
+
+type fileState struct {
+	modTime time.Time
+	size    int64
+}
+
+func NewWatcher(interval time.Duration, paths ...string) *Watcher {
+	w := &Watcher{
+		paths:    paths,
+		interval: interval,
+		stop:     make(chan struct{}),
+	}
+	w.snapshot = w.scan()
+	return w
+}
+
+// Start calls onChange in a new goroutine every time a file is created,
+// modified or removed until Stop is called.
+func (w *Watcher) Start(onChange func()) {
+	go func() {
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-w.stop:
+				return
+			case <-ticker.C:
+				if w.Poll() {
+					onChange()
+				}
+			}
+		}
+	}()
+}
+
+func (w *Watcher) Stop() {
+	close(w.stop)
+}
+
+// Poll scans the paths and reports whether anything changed since the last
+// scan.
+func (w *Watcher) Poll() bool {
+	snapshot := w.scan()
+	changed := !equalSnapshots(w.snapshot, snapshot)
+	w.snapshot = snapshot
+	return changed
+}
+
+func (w *Watcher) scan() map[string]fileState {
+	snapshot := map[string]fileState{}
+
+	for _, path := range w.paths {
+		filepath.WalkDir(path, func(file string, entry fs.DirEntry, err error) error {
+			if err != nil || entry.IsDir() {
+				return nil
+			}
+
+			info, err := entry.Info()
+			if err != nil {
+				return nil
+			}
+
+			snapshot[file] = fileState{modTime: info.ModTime(), size: info.Size()}
+			return nil
+		})
+	}
+
+	return snapshot
+}
+
+func equalSnapshots(a, b map[string]fileState) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for file, state := range a {
+		if other, ok := b[file]; !ok || !other.modTime.Equal(state.modTime) || other.size != state.size {
+			return false
+		}
+	}
+
+	return true
+}
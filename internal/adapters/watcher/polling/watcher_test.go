@@ -0,0 +1,89 @@
+package polling_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/geisonbiazus/blog/internal/adapters/watcher/polling"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWatcher(t *testing.T) {
+	writeFile := func(t *testing.T, path, content string) {
+		assert.Nil(t, os.WriteFile(path, []byte(content), 0644))
+	}
+
+	t.Run("Poll", func(t *testing.T) {
+		t.Run("It reports no changes when nothing changed", func(t *testing.T) {
+			dir := t.TempDir()
+			writeFile(t, filepath.Join(dir, "post.md"), "content")
+			watcher := polling.NewWatcher(time.Second, dir)
+
+			assert.False(t, watcher.Poll())
+		})
+
+		t.Run("It reports created files once", func(t *testing.T) {
+			dir := t.TempDir()
+			watcher := polling.NewWatcher(time.Second, dir)
+
+			assert.Nil(t, os.Mkdir(filepath.Join(dir, "nested"), 0755))
+			writeFile(t, filepath.Join(dir, "nested", "post.md"), "content")
+
+			assert.True(t, watcher.Poll())
+			assert.False(t, watcher.Poll())
+		})
+
+		t.Run("It reports modified files", func(t *testing.T) {
+			dir := t.TempDir()
+			file := filepath.Join(dir, "post.md")
+			writeFile(t, file, "content")
+			watcher := polling.NewWatcher(time.Second, dir)
+
+			assert.Nil(t, os.Chtimes(file, time.Now(), time.Now().Add(time.Minute)))
+
+			assert.True(t, watcher.Poll())
+		})
+
+		t.Run("It reports removed files", func(t *testing.T) {
+			dir := t.TempDir()
+			file := filepath.Join(dir, "post.md")
+			writeFile(t, file, "content")
+			watcher := polling.NewWatcher(time.Second, dir)
+
+			assert.Nil(t, os.Remove(file))
+
+			assert.True(t, watcher.Poll())
+		})
+
+		t.Run("It watches multiple paths", func(t *testing.T) {
+			postDir := t.TempDir()
+			templateDir := t.TempDir()
+			watcher := polling.NewWatcher(time.Second, postDir, templateDir)
+
+			writeFile(t, filepath.Join(templateDir, "layout.html"), "layout")
+
+			assert.True(t, watcher.Poll())
+		})
+	})
+
+	t.Run("Start", func(t *testing.T) {
+		t.Run("It calls the callback when a file changes", func(t *testing.T) {
+			dir := t.TempDir()
+			watcher := polling.NewWatcher(10*time.Millisecond, dir)
+			changed := make(chan bool, 1)
+
+			watcher.Start(func() { changed <- true })
+			defer watcher.Stop()
+
+			writeFile(t, filepath.Join(dir, "post.md"), "content")
+
+			select {
+			case <-changed:
+			case <-time.After(time.Second):
+				t.Fatal("expected the callback to be called")
+			}
+		})
+	})
+}
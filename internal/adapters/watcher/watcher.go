@@ -0,0 +1,11 @@
+package watcher
+
+import (
+	"time"
+
+	"github.com/geisonbiazus/blog/internal/adapters/watcher/polling"
+)
+
+func NewPollingWatcher(interval time.Duration, paths ...string) *polling.Watcher {
+	return polling.NewWatcher(interval, paths...)
+}
@@ -0,0 +1,63 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+type AuthenticateTokenUseCase struct {
+	tokenEncoder     TokenEncoder
+	revokedTokenRepo RevokedTokenRepo
+	userRepo         UserRepo
+}
+
+func NewAuthenticateTokenUseCase(
+	tokenEncoder TokenEncoder,
+	revokedTokenRepo RevokedTokenRepo,
+	userRepo UserRepo,
+) *AuthenticateTokenUseCase {
+	return &AuthenticateTokenUseCase{
+		tokenEncoder:     tokenEncoder,
+		revokedTokenRepo: revokedTokenRepo,
+		userRepo:         userRepo,
+	}
+}
+
+func (u *AuthenticateTokenUseCase) Run(ctx context.Context, token string) (User, error) {
+	decodedToken, err := u.tokenEncoder.Decode(token)
+
+	if errors.Is(err, ErrTokenExpired) {
+		return User{}, ErrTokenExpired
+	}
+
+	if err != nil {
+		return User{}, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	if decodedToken.ID == "" {
+		return User{}, ErrInvalidToken
+	}
+
+	revoked, err := u.revokedTokenRepo.IsTokenRevoked(ctx, decodedToken.ID)
+
+	if err != nil {
+		return User{}, fmt.Errorf("error on AuthenticateTokenUseCase.Run when checking revocation: %w", err)
+	}
+
+	if revoked {
+		return User{}, ErrTokenRevoked
+	}
+
+	user, err := u.userRepo.FindUserByID(ctx, decodedToken.UserID)
+
+	if errors.Is(err, ErrUserNotFound) {
+		return User{}, ErrUserNotFound
+	}
+
+	if err != nil {
+		return User{}, fmt.Errorf("error on AuthenticateTokenUseCase.Run when finding user: %w", err)
+	}
+
+	return user, nil
+}
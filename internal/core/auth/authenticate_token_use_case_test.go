@@ -0,0 +1,103 @@
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	revokedtokenrepo "github.com/geisonbiazus/blog/internal/adapters/revokedtokenrepo/memory"
+	userrepo "github.com/geisonbiazus/blog/internal/adapters/userrepo/memory"
+	"github.com/geisonbiazus/blog/internal/core/auth"
+	"github.com/stretchr/testify/assert"
+)
+
+type authenticateTokenUseCaseFixture struct {
+	ctx              context.Context
+	usecase          *auth.AuthenticateTokenUseCase
+	tokenEncoder     *TokenEncoderSpy
+	revokedTokenRepo *revokedtokenrepo.RevokedTokenRepo
+	userRepo         *userrepo.UserRepo
+}
+
+func TestAuthenticateTokenUseCase(t *testing.T) {
+	setup := func() *authenticateTokenUseCaseFixture {
+		tokenEncoder := NewTokenEncoderSpy()
+		revokedTokenRepo := revokedtokenrepo.NewRevokedTokenRepo()
+		userRepo := userrepo.NewUserRepo()
+		usecase := auth.NewAuthenticateTokenUseCase(tokenEncoder, revokedTokenRepo, userRepo)
+
+		return &authenticateTokenUseCaseFixture{
+			ctx:              context.Background(),
+			usecase:          usecase,
+			tokenEncoder:     tokenEncoder,
+			revokedTokenRepo: revokedTokenRepo,
+			userRepo:         userRepo,
+		}
+	}
+
+	t.Run("It decodes the token and returns the user", func(t *testing.T) {
+		f := setup()
+		user := auth.User{ID: "USER_ID", Name: "Name", Email: "user@example.com"}
+		f.userRepo.CreateUser(f.ctx, user)
+		f.tokenEncoder.DecodeReturnToken = auth.Token{ID: "TOKEN_ID", UserID: "USER_ID"}
+
+		authenticatedUser, err := f.usecase.Run(f.ctx, "token")
+
+		assert.Equal(t, "token", f.tokenEncoder.DecodeReceivedToken)
+		assert.Equal(t, user, authenticatedUser)
+		assert.Nil(t, err)
+	})
+
+	t.Run("It returns ErrTokenExpired when the token is expired", func(t *testing.T) {
+		f := setup()
+		f.tokenEncoder.DecodeReturnError = auth.ErrTokenExpired
+
+		user, err := f.usecase.Run(f.ctx, "token")
+
+		assert.Equal(t, auth.User{}, user)
+		assert.Equal(t, auth.ErrTokenExpired, err)
+	})
+
+	t.Run("It returns ErrInvalidToken when the token can't be decoded", func(t *testing.T) {
+		f := setup()
+		f.tokenEncoder.DecodeReturnError = errors.New("decoding error")
+
+		user, err := f.usecase.Run(f.ctx, "token")
+
+		assert.Equal(t, auth.User{}, user)
+		assert.ErrorIs(t, err, auth.ErrInvalidToken)
+	})
+
+	t.Run("It returns ErrUserNotFound when the user doesn't exist", func(t *testing.T) {
+		f := setup()
+		f.tokenEncoder.DecodeReturnToken = auth.Token{ID: "TOKEN_ID", UserID: "USER_ID"}
+
+		user, err := f.usecase.Run(f.ctx, "token")
+
+		assert.Equal(t, auth.User{}, user)
+		assert.Equal(t, auth.ErrUserNotFound, err)
+	})
+
+	t.Run("It returns ErrInvalidToken when the token has no ID", func(t *testing.T) {
+		f := setup()
+		f.tokenEncoder.DecodeReturnToken = auth.Token{UserID: "USER_ID"}
+
+		user, err := f.usecase.Run(f.ctx, "token")
+
+		assert.Equal(t, auth.User{}, user)
+		assert.Equal(t, auth.ErrInvalidToken, err)
+	})
+
+	t.Run("It returns ErrTokenRevoked when the token was revoked", func(t *testing.T) {
+		f := setup()
+		token := auth.Token{ID: "TOKEN_ID", UserID: "USER_ID"}
+		f.userRepo.CreateUser(f.ctx, auth.User{ID: "USER_ID"})
+		f.revokedTokenRepo.RevokeToken(f.ctx, token)
+		f.tokenEncoder.DecodeReturnToken = token
+
+		user, err := f.usecase.Run(f.ctx, "token")
+
+		assert.Equal(t, auth.User{}, user)
+		assert.Equal(t, auth.ErrTokenRevoked, err)
+	})
+}
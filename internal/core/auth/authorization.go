@@ -0,0 +1,38 @@
+package auth
+
+type Permission string
+
+const (
+	PermissionComment          Permission = "comment"
+	PermissionModerateComments Permission = "moderate_comments"
+	PermissionManageUsers      Permission = "manage_users"
+	PermissionPreviewPosts     Permission = "preview_posts"
+)
+
+var rolePermissions = map[Role][]Permission{
+	RoleAdmin:     {PermissionComment, PermissionModerateComments, PermissionManageUsers, PermissionPreviewPosts},
+	RoleModerator: {PermissionComment, PermissionModerateComments},
+	RoleCommenter: {PermissionComment},
+	RoleBanned:    {},
+}
+
+func (u User) Can(permission Permission) bool {
+	for _, p := range rolePermissions[u.Role] {
+		if p == permission {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Authorize returns ErrForbidden when the user's role doesn't grant the
+// permission. It is meant to be the single place use cases and handlers
+// consult before performing a privileged action.
+func Authorize(user User, permission Permission) error {
+	if !user.Can(permission) {
+		return ErrForbidden
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package auth_test
+
+import (
+	"testing"
+
+	"github.com/geisonbiazus/blog/internal/core/auth"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthorize(t *testing.T) {
+	t.Run("It grants each role its permissions", func(t *testing.T) {
+		expected := map[auth.Role][]auth.Permission{
+			auth.RoleAdmin:     {auth.PermissionComment, auth.PermissionModerateComments, auth.PermissionManageUsers, auth.PermissionPreviewPosts},
+			auth.RoleModerator: {auth.PermissionComment, auth.PermissionModerateComments},
+			auth.RoleCommenter: {auth.PermissionComment},
+			auth.RoleBanned:    {},
+		}
+
+		all := []auth.Permission{
+			auth.PermissionComment,
+			auth.PermissionModerateComments,
+			auth.PermissionManageUsers,
+			auth.PermissionPreviewPosts,
+		}
+
+		for role, granted := range expected {
+			user := auth.User{Role: role}
+
+			for _, permission := range all {
+				if contains(granted, permission) {
+					assert.Nil(t, auth.Authorize(user, permission), "%s should be allowed to %s", role, permission)
+				} else {
+					assert.Equal(t, auth.ErrForbidden, auth.Authorize(user, permission), "%s shouldn't be allowed to %s", role, permission)
+				}
+			}
+		}
+	})
+
+	t.Run("It denies everything to users without a role", func(t *testing.T) {
+		assert.False(t, auth.User{}.Can(auth.PermissionComment))
+	})
+}
+
+func contains(permissions []auth.Permission, permission auth.Permission) bool {
+	for _, p := range permissions {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,67 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/geisonbiazus/blog/internal/core/shared"
+)
+
+type ChangeUserRoleUseCase struct {
+	userRepo  UserRepo
+	txManager shared.TransactionManager
+	publisher shared.Publisher
+}
+
+func NewChangeUserRoleUseCase(userRepo UserRepo, txManager shared.TransactionManager, publisher shared.Publisher) *ChangeUserRoleUseCase {
+	return &ChangeUserRoleUseCase{
+		userRepo:  userRepo,
+		txManager: txManager,
+		publisher: publisher,
+	}
+}
+
+func (u *ChangeUserRoleUseCase) Run(ctx context.Context, actor User, userID string, role Role) error {
+	return u.txManager.Transaction(ctx, func(ctx context.Context) error {
+		return u.run(ctx, actor, userID, role)
+	})
+}
+
+func (u *ChangeUserRoleUseCase) run(ctx context.Context, actor User, userID string, role Role) error {
+	if err := Authorize(actor, PermissionManageUsers); err != nil {
+		return err
+	}
+
+	if !role.IsValid() {
+		return ErrInvalidRole
+	}
+
+	// Admins can't change their own role so the blog is never left without one.
+	if actor.ID == userID {
+		return ErrForbidden
+	}
+
+	user, err := u.userRepo.FindUserByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("error on ChangeUserRoleUseCase.Run when finding user: %w", err)
+	}
+
+	if user.Role == role {
+		return nil
+	}
+
+	previousRole := user.Role
+	user.Role = role
+
+	err = u.userRepo.UpdateUser(ctx, user)
+	if err != nil {
+		return fmt.Errorf("error on ChangeUserRoleUseCase.Run when updating user: %w", err)
+	}
+
+	err = u.publisher.Publish(NewUserRoleChangedEvent(user, previousRole))
+	if err != nil {
+		return fmt.Errorf("error on ChangeUserRoleUseCase.Run when publishing event: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package auth_test
+
+import (
+	"context"
+	"testing"
+
+	fakepublisher "github.com/geisonbiazus/blog/internal/adapters/publisher/fake"
+	"github.com/geisonbiazus/blog/internal/adapters/transactionmanager/fake"
+	userrepo "github.com/geisonbiazus/blog/internal/adapters/userrepo/memory"
+	"github.com/geisonbiazus/blog/internal/core/auth"
+	"github.com/geisonbiazus/blog/internal/core/shared"
+	"github.com/stretchr/testify/suite"
+)
+
+type ChangeUserRoleUseCaseSuite struct {
+	suite.Suite
+	usecase   *auth.ChangeUserRoleUseCase
+	userRepo  *userrepo.UserRepo
+	publisher *fakepublisher.Publisher
+	ctx       context.Context
+	admin     auth.User
+	user      auth.User
+}
+
+func (s *ChangeUserRoleUseCaseSuite) SetupSubTest() {
+	s.ctx = context.Background()
+	s.userRepo = userrepo.NewUserRepo()
+	s.publisher = fakepublisher.NewPublisher()
+	s.usecase = auth.NewChangeUserRoleUseCase(s.userRepo, fake.NewTransactionManager(), s.publisher)
+
+	s.admin = auth.User{ID: "ADMIN_ID", Role: auth.RoleAdmin}
+	s.user = auth.User{ID: "USER_ID", Role: auth.RoleCommenter}
+
+	s.userRepo.CreateUser(s.ctx, s.admin)
+	s.userRepo.CreateUser(s.ctx, s.user)
+}
+
+func (s *ChangeUserRoleUseCaseSuite) TestRun() {
+	s.Run("It changes the role of the user and publishes the change", func() {
+		err := s.usecase.Run(s.ctx, s.admin, s.user.ID, auth.RoleBanned)
+
+		user, _ := s.userRepo.FindUserByID(s.ctx, s.user.ID)
+
+		s.Nil(err)
+		s.Equal(auth.RoleBanned, user.Role)
+		s.Equal(shared.Event{
+			Type:       auth.UserRoleChangedEvent,
+			OccurredOn: s.publisher.LastEvent().OccurredOn,
+			Payload: map[string]interface{}{
+				"ID":           s.user.ID,
+				"Role":         "banned",
+				"PreviousRole": "commenter",
+			},
+		}, s.publisher.LastEvent())
+	})
+
+	s.Run("It doesn't publish anything when the role is unchanged", func() {
+		err := s.usecase.Run(s.ctx, s.admin, s.user.ID, auth.RoleCommenter)
+
+		s.Nil(err)
+		s.Empty(s.publisher.Events)
+	})
+
+	s.Run("It returns ErrForbidden when the actor can't manage users", func() {
+		moderator := auth.User{ID: "MODERATOR_ID", Role: auth.RoleModerator}
+
+		err := s.usecase.Run(s.ctx, moderator, s.user.ID, auth.RoleBanned)
+
+		user, _ := s.userRepo.FindUserByID(s.ctx, s.user.ID)
+
+		s.Equal(auth.ErrForbidden, err)
+		s.Equal(auth.RoleCommenter, user.Role)
+	})
+
+	s.Run("It returns ErrForbidden when admins change their own role", func() {
+		err := s.usecase.Run(s.ctx, s.admin, s.admin.ID, auth.RoleCommenter)
+
+		s.Equal(auth.ErrForbidden, err)
+	})
+
+	s.Run("It returns ErrInvalidRole when the role is unknown", func() {
+		err := s.usecase.Run(s.ctx, s.admin, s.user.ID, auth.Role("owner"))
+
+		s.Equal(auth.ErrInvalidRole, err)
+	})
+
+	s.Run("It returns ErrUserNotFound when the user doesn't exist", func() {
+		err := s.usecase.Run(s.ctx, s.admin, "UNKNOWN_ID", auth.RoleBanned)
+
+		s.ErrorIs(err, auth.ErrUserNotFound)
+	})
+}
+
+func TestChangeUserRoleUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(ChangeUserRoleUseCaseSuite))
+}
@@ -17,6 +17,7 @@ type ConfirmOAuth2UseCase struct {
 	tokenEncoder TokenEncoder
 	txManager    shared.TransactionManager
 	publisher    shared.Publisher
+	adminIDs     []string
 }
 
 func NewConfirmOAuth2UseCase(
@@ -27,6 +28,7 @@ func NewConfirmOAuth2UseCase(
 	tokenEncoder TokenEncoder,
 	txManager shared.TransactionManager,
 	publisher shared.Publisher,
+	adminProviderUserIDs []string,
 ) *ConfirmOAuth2UseCase {
 	return &ConfirmOAuth2UseCase{
 		provider:     provider,
@@ -36,6 +38,7 @@ func NewConfirmOAuth2UseCase(
 		tokenEncoder: tokenEncoder,
 		txManager:    txManager,
 		publisher:    publisher,
+		adminIDs:     adminProviderUserIDs,
 	}
 }
 
@@ -122,6 +125,11 @@ func (u *ConfirmOAuth2UseCase) createNewUser(ctx context.Context, providerUser P
 		Email:          providerUser.Email,
 		Name:           providerUser.Name,
 		AvatarURL:      providerUser.AvatarURL,
+		Role:           RoleCommenter,
+	}
+
+	if u.isBootstrapAdmin(providerUser) {
+		user.Role = RoleAdmin
 	}
 
 	err := u.userRepo.CreateUser(ctx, user)
@@ -142,6 +150,11 @@ func (u *ConfirmOAuth2UseCase) updateExistingUser(ctx context.Context, user User
 	user.Name = providerUser.Name
 	user.AvatarURL = providerUser.AvatarURL
 
+	previousRole := user.Role
+	if u.isBootstrapAdmin(providerUser) {
+		user.Role = RoleAdmin
+	}
+
 	err := u.userRepo.UpdateUser(ctx, user)
 	if err != nil {
 		return User{}, fmt.Errorf("error updating user on ConfirmOAuth2UseCase: %w", err)
@@ -152,9 +165,29 @@ func (u *ConfirmOAuth2UseCase) updateExistingUser(ctx context.Context, user User
 		return User{}, fmt.Errorf("error publishing event on ConfirmOAuth2UseCase: %w", err)
 	}
 
+	if user.Role != previousRole {
+		err = u.publisher.Publish(NewUserRoleChangedEvent(user, previousRole))
+		if err != nil {
+			return User{}, fmt.Errorf("error publishing event on ConfirmOAuth2UseCase: %w", err)
+		}
+	}
+
 	return user, nil
 }
 
+// isBootstrapAdmin tells whether the provider user is one of the configured
+// owners of the blog, who are granted admin on every login. It never demotes
+// anyone, so roles given afterwards are kept for everybody else.
+func (u *ConfirmOAuth2UseCase) isBootstrapAdmin(providerUser ProviderUser) bool {
+	for _, id := range u.adminIDs {
+		if id == providerUser.ID {
+			return true
+		}
+	}
+
+	return false
+}
+
 const TokenExpiration = 24 * time.Hour
 
 func (u *ConfirmOAuth2UseCase) getAuthenticationToken(user User) (string, error) {
@@ -38,7 +38,7 @@ func TestConfirmOAuth2UseCase(t *testing.T) {
 		AvatarURL: "http://example.com/avatar.png",
 	}
 
-	setup := func() *confirmOAuth2UseCaseFixture {
+	setup := func(adminProviderUserIDs ...string) *confirmOAuth2UseCaseFixture {
 		provider := NewOAuth2ProviderSpy()
 		stateRepo := staterepo.NewStateRepo()
 		userRepo := userrepo.NewUserRepo()
@@ -46,7 +46,7 @@ func TestConfirmOAuth2UseCase(t *testing.T) {
 		tokenEncoder := NewTokenEncoderSpy()
 		txManager := fake.NewTransactionManager()
 		publisher := fakepublisher.NewPublisher()
-		usecase := auth.NewConfirmOAuth2UseCase(provider, stateRepo, userRepo, idGen, tokenEncoder, txManager, publisher)
+		usecase := auth.NewConfirmOAuth2UseCase(provider, stateRepo, userRepo, idGen, tokenEncoder, txManager, publisher, adminProviderUserIDs)
 		return &confirmOAuth2UseCaseFixture{
 			usecase:      usecase,
 			provider:     provider,
@@ -108,6 +108,7 @@ func TestConfirmOAuth2UseCase(t *testing.T) {
 			Email:          providerUser.Email,
 			Name:           providerUser.Name,
 			AvatarURL:      providerUser.AvatarURL,
+			Role:           auth.RoleCommenter,
 		}
 
 		createdUser, _ := f.userRepo.FindUserByEmail(f.ctx, providerUser.Email)
@@ -139,6 +140,7 @@ func TestConfirmOAuth2UseCase(t *testing.T) {
 			Email:          "previous.email@example.com",
 			Name:           "previous name",
 			AvatarURL:      "http://example.com/previous_avatar.png",
+			Role:           auth.RoleAdmin,
 		}
 
 		f.userRepo.CreateUser(f.ctx, user)
@@ -151,6 +153,7 @@ func TestConfirmOAuth2UseCase(t *testing.T) {
 			Email:          providerUser.Email,
 			Name:           providerUser.Name,
 			AvatarURL:      providerUser.AvatarURL,
+			Role:           user.Role,
 		}
 
 		createdUser, _ := f.userRepo.FindUserByEmail(f.ctx, providerUser.Email)
@@ -169,6 +172,45 @@ func TestConfirmOAuth2UseCase(t *testing.T) {
 		}, f.publisher.LastEvent())
 	})
 
+	t.Run("It creates configured admins with the admin role", func(t *testing.T) {
+		f := setup(providerUser.ID)
+
+		f.stateRepo.AddState(state)
+		f.idGen.ReturnID = "generatedID"
+		f.provider.AuthenticatedUserReturnProviderUser = providerUser
+
+		f.usecase.Run(f.ctx, state, code)
+
+		createdUser, _ := f.userRepo.FindUserByID(f.ctx, "generatedID")
+
+		assert.Equal(t, auth.RoleAdmin, createdUser.Role)
+	})
+
+	t.Run("It promotes existing configured admins and publishes the role change", func(t *testing.T) {
+		f := setup(providerUser.ID)
+
+		f.stateRepo.AddState(state)
+		f.provider.AuthenticatedUserReturnProviderUser = providerUser
+
+		user := auth.User{ID: "existing user ID", ProviderUserID: providerUser.ID, Role: auth.RoleCommenter}
+		f.userRepo.CreateUser(f.ctx, user)
+
+		f.usecase.Run(f.ctx, state, code)
+
+		updatedUser, _ := f.userRepo.FindUserByID(f.ctx, user.ID)
+
+		assert.Equal(t, auth.RoleAdmin, updatedUser.Role)
+		assert.Equal(t, shared.Event{
+			Type:       auth.UserRoleChangedEvent,
+			OccurredOn: f.publisher.LastEvent().OccurredOn,
+			Payload: map[string]interface{}{
+				"ID":           user.ID,
+				"Role":         "admin",
+				"PreviousRole": "commenter",
+			},
+		}, f.publisher.LastEvent())
+	})
+
 	t.Run("It authenticates the user and returns the authentication token", func(t *testing.T) {
 		f := setup()
 
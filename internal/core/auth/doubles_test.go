@@ -49,6 +49,10 @@ type TokenEncoderSpy struct {
 	EncodeReturnError       error
 	EncodeReceivedValue     string
 	EncodeReceivedExpiresIn time.Duration
+
+	DecodeReturnToken   auth.Token
+	DecodeReturnError   error
+	DecodeReceivedToken string
 }
 
 func NewTokenEncoderSpy() *TokenEncoderSpy {
@@ -60,3 +64,8 @@ func (m *TokenEncoderSpy) Encode(value string, expiresIn time.Duration) (string,
 	m.EncodeReceivedExpiresIn = expiresIn
 	return m.EncodeReturnToken, m.EncodeReturnError
 }
+
+func (m *TokenEncoderSpy) Decode(token string) (auth.Token, error) {
+	m.DecodeReceivedToken = token
+	return m.DecodeReturnToken, m.DecodeReturnError
+}
@@ -1,6 +1,9 @@
 package auth
 
-import "errors"
+import (
+	"errors"
+	"time"
+)
 
 type ProviderUser struct {
 	ID        string
@@ -9,14 +12,42 @@ type ProviderUser struct {
 	AvatarURL string
 }
 
+type Role string
+
+const (
+	RoleAdmin     Role = "admin"
+	RoleModerator Role = "moderator"
+	RoleCommenter Role = "commenter"
+	RoleBanned    Role = "banned"
+)
+
+func (r Role) IsValid() bool {
+	switch r {
+	case RoleAdmin, RoleModerator, RoleCommenter, RoleBanned:
+		return true
+	}
+	return false
+}
+
 type User struct {
 	ID             string
 	ProviderUserID string
 	Email          string
 	Name           string
 	AvatarURL      string
+	Role           Role
+}
+
+type Token struct {
+	ID        string
+	UserID    string
+	ExpiresAt time.Time
 }
 
 var ErrInvalidState = errors.New("invalid state error")
 var ErrUserNotFound = errors.New("user not found")
 var ErrTokenExpired = errors.New("token expired")
+var ErrInvalidToken = errors.New("invalid token")
+var ErrTokenRevoked = errors.New("token revoked")
+var ErrInvalidRole = errors.New("invalid role")
+var ErrForbidden = errors.New("forbidden")
@@ -7,8 +7,9 @@ import (
 )
 
 const (
-	UserCreatedEvent = "UserCreated"
-	UserUpdatedEvent = "UserUpdated"
+	UserCreatedEvent     = "UserCreated"
+	UserUpdatedEvent     = "UserUpdated"
+	UserRoleChangedEvent = "UserRoleChanged"
 )
 
 func NewUserCreatedEvent(user User) shared.Event {
@@ -36,3 +37,15 @@ func NewUserUpdatedEvent(user User) shared.Event {
 		},
 	}
 }
+
+func NewUserRoleChangedEvent(user User, previousRole Role) shared.Event {
+	return shared.Event{
+		Type:       UserRoleChangedEvent,
+		OccurredOn: time.Now(),
+		Payload: map[string]interface{}{
+			"ID":           user.ID,
+			"Role":         string(user.Role),
+			"PreviousRole": string(previousRole),
+		},
+	}
+}
@@ -0,0 +1,35 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+)
+
+type LogoutUseCase struct {
+	tokenEncoder     TokenEncoder
+	revokedTokenRepo RevokedTokenRepo
+}
+
+func NewLogoutUseCase(tokenEncoder TokenEncoder, revokedTokenRepo RevokedTokenRepo) *LogoutUseCase {
+	return &LogoutUseCase{
+		tokenEncoder:     tokenEncoder,
+		revokedTokenRepo: revokedTokenRepo,
+	}
+}
+
+func (u *LogoutUseCase) Run(ctx context.Context, token string) error {
+	decodedToken, err := u.tokenEncoder.Decode(token)
+
+	if err != nil || decodedToken.ID == "" {
+		// Expired or invalid tokens are already unusable, so there is nothing to revoke.
+		return nil
+	}
+
+	err = u.revokedTokenRepo.RevokeToken(ctx, decodedToken)
+
+	if err != nil {
+		return fmt.Errorf("error on LogoutUseCase.Run when revoking token: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	revokedtokenrepo "github.com/geisonbiazus/blog/internal/adapters/revokedtokenrepo/memory"
+	"github.com/geisonbiazus/blog/internal/core/auth"
+	"github.com/stretchr/testify/assert"
+)
+
+type logoutUseCaseFixture struct {
+	ctx              context.Context
+	usecase          *auth.LogoutUseCase
+	tokenEncoder     *TokenEncoderSpy
+	revokedTokenRepo *revokedtokenrepo.RevokedTokenRepo
+}
+
+func TestLogoutUseCase(t *testing.T) {
+	setup := func() *logoutUseCaseFixture {
+		tokenEncoder := NewTokenEncoderSpy()
+		revokedTokenRepo := revokedtokenrepo.NewRevokedTokenRepo()
+		usecase := auth.NewLogoutUseCase(tokenEncoder, revokedTokenRepo)
+
+		return &logoutUseCaseFixture{
+			ctx:              context.Background(),
+			usecase:          usecase,
+			tokenEncoder:     tokenEncoder,
+			revokedTokenRepo: revokedTokenRepo,
+		}
+	}
+
+	t.Run("It revokes the given token", func(t *testing.T) {
+		f := setup()
+		f.tokenEncoder.DecodeReturnToken = auth.Token{
+			ID:        "TOKEN_ID",
+			UserID:    "USER_ID",
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+
+		err := f.usecase.Run(f.ctx, "token")
+		revoked, _ := f.revokedTokenRepo.IsTokenRevoked(f.ctx, "TOKEN_ID")
+
+		assert.Nil(t, err)
+		assert.Equal(t, "token", f.tokenEncoder.DecodeReceivedToken)
+		assert.True(t, revoked)
+	})
+
+	t.Run("It does nothing when the token is expired", func(t *testing.T) {
+		f := setup()
+		f.tokenEncoder.DecodeReturnError = auth.ErrTokenExpired
+
+		err := f.usecase.Run(f.ctx, "token")
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("It does nothing when the token is invalid", func(t *testing.T) {
+		f := setup()
+		f.tokenEncoder.DecodeReturnError = errors.New("decoding error")
+
+		err := f.usecase.Run(f.ctx, "token")
+
+		assert.Nil(t, err)
+	})
+}
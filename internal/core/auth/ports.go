@@ -19,9 +19,16 @@ type StateRepo interface {
 type UserRepo interface {
 	CreateUser(ctx context.Context, user User) error
 	UpdateUser(ctx context.Context, user User) error
+	FindUserByID(ctx context.Context, id string) (User, error)
 	FindUserByProviderUserID(ctx context.Context, providerUserID string) (User, error)
 }
 
 type TokenEncoder interface {
 	Encode(value string, expiresIn time.Duration) (string, error)
+	Decode(token string) (Token, error)
+}
+
+type RevokedTokenRepo interface {
+	RevokeToken(ctx context.Context, token Token) error
+	IsTokenRevoked(ctx context.Context, tokenID string) (bool, error)
 }
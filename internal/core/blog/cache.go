@@ -0,0 +1,43 @@
+package blog
+
+import (
+	"errors"
+	"time"
+
+	"github.com/geisonbiazus/blog/internal/core/shared"
+)
+
+// Every use case shares the same cache, so keys are namespaced to keep post
+// paths from reaching the values of the listings.
+const (
+	allPostsCacheKey       = "posts:all"
+	publishedPostsCacheKey = "posts:published"
+	searchIndexCacheKey    = "posts:search-index"
+	renderedPostsCacheKey  = "posts:rendered"
+	relatedPostsCacheKey   = "posts:related"
+	postCacheKeyPrefix     = "post:"
+)
+
+var ErrUnexpectedCachedValue = errors.New("cached value has an unexpected type")
+
+// cachedValue resolves the value of the key through the cache, returning an
+// error instead of panicking when the cached value isn't a T. Errors are
+// checked first as calls waiting for a failed resolution get no value.
+func cachedValue[T any](cache shared.Cache, key string, resolve func() (T, error), expiresIn time.Duration) (T, error) {
+	var zero T
+
+	result, err := cache.Do(key, func() (interface{}, error) {
+		return resolve()
+	}, expiresIn)
+
+	value, ok := result.(T)
+	if err != nil {
+		return value, err
+	}
+
+	if !ok {
+		return zero, ErrUnexpectedCachedValue
+	}
+
+	return value, nil
+}
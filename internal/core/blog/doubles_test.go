@@ -1,6 +1,11 @@
 package blog_test
 
-import "github.com/geisonbiazus/blog/internal/core/blog"
+import (
+	"time"
+
+	"github.com/geisonbiazus/blog/internal/core/blog"
+	"github.com/geisonbiazus/blog/internal/core/shared"
+)
 
 type PostRepoSpy struct {
 	ReceivedPath string
@@ -26,13 +31,73 @@ type RendererSpy struct {
 	ReceivedContent       string
 	ReturnError           error
 	ReturnRenderedContent string
+	ReturnHeadings        []blog.Heading
 }
 
 func NewRendererSpy() *RendererSpy {
 	return &RendererSpy{}
 }
 
-func (r *RendererSpy) Render(content string) (string, error) {
+func (r *RendererSpy) Render(content string) (string, []blog.Heading, error) {
 	r.ReceivedContent = content
-	return r.ReturnRenderedContent, r.ReturnError
+	return r.ReturnRenderedContent, r.ReturnHeadings, r.ReturnError
+}
+
+type PreviewSignerSpy struct {
+	ReceivedValue     string
+	ReceivedSignature string
+	ReturnValid       bool
+}
+
+func NewPreviewSignerSpy() *PreviewSignerSpy {
+	return &PreviewSignerSpy{}
+}
+
+func (s *PreviewSignerSpy) Sign(value string) string {
+	s.ReceivedValue = value
+	return "signature-" + value
+}
+
+func (s *PreviewSignerSpy) Verify(value, signature string) bool {
+	s.ReceivedValue = value
+	s.ReceivedSignature = signature
+	return s.ReturnValid
+}
+
+type CacheSpy struct {
+	shared.Cache
+	ReceivedExpiresIn map[string]time.Duration
+}
+
+func NewCacheSpy(cache shared.Cache) *CacheSpy {
+	return &CacheSpy{Cache: cache, ReceivedExpiresIn: map[string]time.Duration{}}
+}
+
+func (c *CacheSpy) Do(key string, resolve shared.ResolveFn, expiresIn time.Duration) (interface{}, error) {
+	c.ReceivedExpiresIn[key] = expiresIn
+	return c.Cache.Do(key, resolve, expiresIn)
+}
+
+type PostIndexSpy struct {
+	IndexCalls     int
+	ReceivedPosts  []blog.Post
+	ReceivedQuery  string
+	ReturnMatches  []blog.SearchMatch
+	ReturnIndexErr error
+	ReturnError    error
+}
+
+func NewPostIndexSpy() *PostIndexSpy {
+	return &PostIndexSpy{ReturnMatches: []blog.SearchMatch{}}
+}
+
+func (i *PostIndexSpy) Index(posts []blog.Post) error {
+	i.IndexCalls++
+	i.ReceivedPosts = posts
+	return i.ReturnIndexErr
+}
+
+func (i *PostIndexSpy) Search(query string) ([]blog.SearchMatch, error) {
+	i.ReceivedQuery = query
+	return i.ReturnMatches, i.ReturnError
 }
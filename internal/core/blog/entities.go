@@ -1,23 +1,418 @@
 package blog
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
 	"time"
+	"unicode"
+
+	"github.com/geisonbiazus/blog/internal/core/shared"
 )
 
 type Post struct {
 	Title       string
 	Author      string
 	Time        time.Time
+	UpdatedAt   time.Time
 	Path        string
 	Description string
 	ImagePath   string
+	Tags        []string
+	Series      string
+	SeriesOrder int
+	Status      PostStatus
+	TOC         bool
+	Params      map[string]interface{}
 	Markdown    string
+	Metadata    PostMetadata
+}
+
+type PostStatus string
+
+const (
+	PostPublished PostStatus = "published"
+	PostDraft     PostStatus = "draft"
+)
+
+func (s PostStatus) IsValid() bool {
+	return s == PostPublished || s == PostDraft
+}
+
+func (p Post) IsDraft() bool {
+	return p.Status == PostDraft
 }
 
+func (p Post) IsScheduled(now time.Time) bool {
+	return !p.IsDraft() && p.Time.After(now)
+}
+
+// IsPublished tells whether the post is visible to readers at the given time.
+// Posts without a status are considered published.
+func (p Post) IsPublished(now time.Time) bool {
+	return !p.IsDraft() && !p.IsScheduled(now)
+}
+
+// Summary returns the description of the post, or its excerpt when it has no
+// description.
+func (p Post) Summary() string {
+	if p.Description != "" {
+		return p.Description
+	}
+
+	return p.Metadata.Excerpt
+}
+
+const excerptWords = 40
+const wordsPerMinute = 200
+
+// PostMetadata is derived from the content of the post. It is computed once
+// when the post is loaded, as it is shown on every listing. The hash changes
+// with any change to the post, e.g. to tell clients their copy is outdated.
+type PostMetadata struct {
+	WordCount   int
+	ReadingTime time.Duration
+	Excerpt     string
+	Hash        string
+}
+
+func NewPostMetadata(markdown string) PostMetadata {
+	words := len(strings.Fields(PlainText(markdown)))
+
+	return PostMetadata{
+		WordCount:   words,
+		ReadingTime: readingTime(words),
+		Excerpt:     excerpt(markdown),
+	}
+}
+
+// readingTime rounds up to whole minutes so short posts take at least one.
+func readingTime(words int) time.Duration {
+	if words == 0 {
+		return 0
+	}
+
+	return time.Duration((words+wordsPerMinute-1)/wordsPerMinute) * time.Minute
+}
+
+// excerpt returns the first paragraph of the content as plain text, cut after
+// a few words when it is too long.
+func excerpt(markdown string) string {
+	words := strings.Fields(PlainText(firstParagraph(markdown)))
+
+	if len(words) <= excerptWords {
+		return strings.Join(words, " ")
+	}
+
+	return strings.Join(words[:excerptWords], " ") + "…"
+}
+
+// firstParagraph skips headings, code blocks, images and HTML, returning the
+// lines of the first block of text.
+func firstParagraph(markdown string) string {
+	lines := []string{}
+	inCodeBlock := false
+
+	for _, line := range strings.Split(markdown, "\n") {
+		trimmed := strings.TrimSpace(line)
+
+		if strings.HasPrefix(trimmed, "```") {
+			inCodeBlock = !inCodeBlock
+			continue
+		}
+
+		if inCodeBlock || !isParagraphLine(trimmed) {
+			if len(lines) > 0 {
+				break
+			}
+			continue
+		}
+
+		lines = append(lines, trimmed)
+	}
+
+	return strings.Join(lines, " ")
+}
+
+func isParagraphLine(line string) bool {
+	if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "<") || strings.HasPrefix(line, "|") {
+		return false
+	}
+
+	return PlainText(line) != ""
+}
+
+// LastModified returns when the post last changed, which is its publication
+// time unless its content was updated afterwards.
+func (p Post) LastModified() time.Time {
+	if p.UpdatedAt.After(p.Time) {
+		return p.UpdatedAt
+	}
+
+	return p.Time
+}
+
+func (p Post) SeriesSlug() string {
+	return Slugify(p.Series)
+}
+
+func (p Post) AuthorSlug() string {
+	return Slugify(p.Author)
+}
+
+func (p Post) HasTag(tag string) bool {
+	for _, t := range p.Tags {
+		if t == tag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Diagnostic describes a problem found in a post file. Line is 0 when the
+// problem concerns the whole file.
+type Diagnostic struct {
+	File    string
+	Line    int
+	Message string
+}
+
+func (d Diagnostic) String() string {
+	if d.Line == 0 {
+		return fmt.Sprintf("%s: %s", d.File, d.Message)
+	}
+
+	return fmt.Sprintf("%s:%d: %s", d.File, d.Line, d.Message)
+}
+
+type ValidationError struct {
+	Diagnostics []Diagnostic
+}
+
+func (e *ValidationError) Error() string {
+	lines := []string{fmt.Sprintf("%d problem(s) found in posts:", len(e.Diagnostics))}
+
+	for _, d := range e.Diagnostics {
+		lines = append(lines, "  "+d.String())
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// RenderedPost is hashed when rendered, as the HTML may change with the
+// renderer even when the post doesn't.
 type RenderedPost struct {
-	Post Post
-	HTML string
+	Post     Post
+	HTML     string
+	Headings []Heading
+	Hash     string
+}
+
+func NewRenderedPost(post Post, html string, headings []Heading) RenderedPost {
+	return RenderedPost{
+		Post:     post,
+		HTML:     html,
+		Headings: headings,
+		Hash:     ContentHash(post.Metadata.Hash, html),
+	}
+}
+
+// ContentHash identifies the given content, each part being hashed apart so
+// moving text from one part to the next changes the hash.
+func ContentHash(parts ...string) string {
+	hash := sha256.New()
+
+	for _, part := range parts {
+		fmt.Fprintf(hash, "%d:%s;", len(part), part)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// Heading is a section of the rendered post. The ID is the anchor of the
+// heading in the HTML and the children are its subsections.
+type Heading struct {
+	Level    int
+	Text     string
+	ID       string
+	Children []Heading
+}
+
+func publishedPosts(posts []Post, now time.Time) []Post {
+	result := []Post{}
+
+	for _, post := range posts {
+		if post.IsPublished(now) {
+			result = append(result, post)
+		}
+	}
+
+	return result
+}
+
+// untilNextPublication returns how long until the next scheduled post goes
+// live, or NeverExpire when nothing is scheduled.
+func untilNextPublication(posts []Post, now time.Time) time.Duration {
+	next := shared.NeverExpire
+
+	for _, post := range posts {
+		if !post.IsScheduled(now) {
+			continue
+		}
+
+		if until := post.Time.Sub(now); next == shared.NeverExpire || until < next {
+			next = until
+		}
+	}
+
+	return next
+}
+
+const DefaultPostsPerPage = 10
+const MaxPostsPerPage = 100
+
+// PostPage is a page of the published posts. Pages start at 1.
+type PostPage struct {
+	Posts      []Post
+	Page       int
+	Limit      int
+	TotalPosts int
+}
+
+func (p PostPage) TotalPages() int {
+	return (p.TotalPosts + p.Limit - 1) / p.Limit
+}
+
+func (p PostPage) HasPrevious() bool {
+	return p.Page > 1
+}
+
+func (p PostPage) HasNext() bool {
+	return p.Page < p.TotalPages()
+}
+
+type PostPreview struct {
+	Post      Post
+	Signature string
+}
+
+type TagCount struct {
+	Tag   string
+	Count int
+}
+
+// CountTags returns how many of the given posts use each tag, sorted by tag.
+func CountTags(posts []Post) []TagCount {
+	counts := map[string]int{}
+
+	for _, post := range posts {
+		for _, tag := range post.Tags {
+			counts[tag]++
+		}
+	}
+
+	result := []TagCount{}
+
+	for tag, count := range counts {
+		result = append(result, TagCount{Tag: tag, Count: count})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Tag < result[j].Tag
+	})
+
+	return result
+}
+
+type Series struct {
+	Slug  string
+	Title string
+	Posts []Post
+}
+
+// Part returns the 1-based position of the post with the given path in the
+// series, or 0 when the post doesn't belong to it.
+func (s Series) Part(path string) int {
+	for i, post := range s.Posts {
+		if post.Path == path {
+			return i + 1
+		}
+	}
+
+	return 0
+}
+
+func (s Series) Previous(path string) (Post, bool) {
+	return s.postAt(s.Part(path) - 1)
+}
+
+func (s Series) Next(path string) (Post, bool) {
+	if s.Part(path) == 0 {
+		return Post{}, false
+	}
+
+	return s.postAt(s.Part(path) + 1)
+}
+
+func (s Series) postAt(part int) (Post, bool) {
+	if part < 1 || part > len(s.Posts) {
+		return Post{}, false
+	}
+
+	return s.Posts[part-1], true
+}
+
+var markdownImageRegexp = regexp.MustCompile(`!\[[^\]]*\]\([^)]*\)`)
+var markdownLinkRegexp = regexp.MustCompile(`\[([^\]]*)\]\([^)]*\)`)
+var markdownSymbolsRegexp = regexp.MustCompile("[#*_`>~|]+")
+
+// PlainText removes the Markdown syntax from the content, keeping only the
+// words, e.g. to be shown in excerpts.
+func PlainText(markdown string) string {
+	text := markdownImageRegexp.ReplaceAllString(markdown, "")
+	text = markdownLinkRegexp.ReplaceAllString(text, "$1")
+	text = markdownSymbolsRegexp.ReplaceAllString(text, "")
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// Slugify turns a title into a lowercase identifier safe to be used in URLs,
+// e.g. "Algorithms & Data Structures" becomes "algorithms-data-structures".
+func Slugify(title string) string {
+	words := strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	return strings.Join(words, "-")
+}
+
+// SearchMatch is a post found by the PostIndex, identified by its path.
+type SearchMatch struct {
+	Path    string
+	Score   float64
+	Snippet Snippet
+}
+
+type SearchResult struct {
+	Post    Post
+	Score   float64
+	Snippet Snippet
+}
+
+// Snippet is an excerpt of a post with the words matching the search query
+// highlighted.
+type Snippet []SnippetFragment
+
+type SnippetFragment struct {
+	Text        string
+	Highlighted bool
 }
 
 var ErrPostNotFound = errors.New("post not found")
+var ErrPageNotFound = errors.New("page not found")
+var ErrSeriesNotFound = errors.New("series not found")
+var ErrInvalidPreviewSignature = errors.New("invalid preview signature")
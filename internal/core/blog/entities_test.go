@@ -0,0 +1,203 @@
+package blog_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/geisonbiazus/blog/internal/core/blog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountTags(t *testing.T) {
+	t.Run("It counts the posts of each tag sorted by tag", func(t *testing.T) {
+		posts := []blog.Post{
+			{Tags: []string{"go", "testing"}},
+			{Tags: []string{"algorithms", "go"}},
+			{},
+		}
+
+		assert.Equal(t, []blog.TagCount{
+			{Tag: "algorithms", Count: 1},
+			{Tag: "go", Count: 2},
+			{Tag: "testing", Count: 1},
+		}, blog.CountTags(posts))
+	})
+
+	t.Run("It returns an empty slice when there are no tags", func(t *testing.T) {
+		assert.Equal(t, []blog.TagCount{}, blog.CountTags([]blog.Post{}))
+	})
+}
+
+func TestPostPage(t *testing.T) {
+	t.Run("It calculates the total of pages", func(t *testing.T) {
+		assert.Equal(t, 0, blog.PostPage{Limit: 10}.TotalPages())
+		assert.Equal(t, 1, blog.PostPage{Limit: 10, TotalPosts: 10}.TotalPages())
+		assert.Equal(t, 2, blog.PostPage{Limit: 10, TotalPosts: 11}.TotalPages())
+	})
+
+	t.Run("It tells whether there are previous and next pages", func(t *testing.T) {
+		first := blog.PostPage{Page: 1, Limit: 10, TotalPosts: 25}
+		middle := blog.PostPage{Page: 2, Limit: 10, TotalPosts: 25}
+		last := blog.PostPage{Page: 3, Limit: 10, TotalPosts: 25}
+
+		assert.False(t, first.HasPrevious())
+		assert.True(t, first.HasNext())
+		assert.True(t, middle.HasPrevious())
+		assert.True(t, middle.HasNext())
+		assert.True(t, last.HasPrevious())
+		assert.False(t, last.HasNext())
+	})
+}
+
+func TestPostSummary(t *testing.T) {
+	t.Run("It returns the description when there is one", func(t *testing.T) {
+		post := blog.Post{Description: "Description", Markdown: "Content"}
+
+		assert.Equal(t, "Description", post.Summary())
+	})
+
+	t.Run("It returns the first paragraph of the content as plain text otherwise", func(t *testing.T) {
+		markdown := "## Subtitle\n\nSome **bold** [link](https://example.com) ![image](/image.png)\nin two lines\n\nSecond paragraph\n"
+		post := blog.Post{Markdown: markdown, Metadata: blog.NewPostMetadata(markdown)}
+
+		assert.Equal(t, "Some bold link in two lines", post.Summary())
+	})
+
+	t.Run("It skips code blocks, images and HTML in the excerpt", func(t *testing.T) {
+		metadata := blog.NewPostMetadata("![image](/image.png)\n\n```go\nfunc main() {}\n```\n\n<div>Box</div>\n\nThe paragraph\n")
+
+		assert.Equal(t, "The paragraph", metadata.Excerpt)
+	})
+
+	t.Run("It cuts long content in the excerpt", func(t *testing.T) {
+		metadata := blog.NewPostMetadata(strings.Repeat("word ", 50))
+
+		assert.Equal(t, strings.TrimSpace(strings.Repeat("word ", 40))+"…", metadata.Excerpt)
+	})
+}
+
+func TestPostLastModified(t *testing.T) {
+	published := time.Date(2021, 4, 5, 0, 0, 0, 0, time.UTC)
+
+	t.Run("It returns the update time when it is after the publication", func(t *testing.T) {
+		post := blog.Post{Time: published, UpdatedAt: published.Add(time.Hour)}
+
+		assert.Equal(t, published.Add(time.Hour), post.LastModified())
+	})
+
+	t.Run("It returns the publication time otherwise", func(t *testing.T) {
+		assert.Equal(t, published, blog.Post{Time: published}.LastModified())
+		assert.Equal(t, published, blog.Post{Time: published, UpdatedAt: published.Add(-time.Hour)}.LastModified())
+	})
+}
+
+func TestPostMetadata(t *testing.T) {
+	t.Run("It counts the words and estimates the reading time in whole minutes", func(t *testing.T) {
+		metadata := blog.NewPostMetadata("# Title\n\n" + strings.Repeat("word ", 250))
+
+		assert.Equal(t, 251, metadata.WordCount)
+		assert.Equal(t, 2*time.Minute, metadata.ReadingTime)
+		assert.Equal(t, strings.TrimSpace(strings.Repeat("word ", 40))+"…", metadata.Excerpt)
+	})
+
+	t.Run("Given no content, it returns empty metadata", func(t *testing.T) {
+		assert.Equal(t, blog.PostMetadata{}, blog.NewPostMetadata(""))
+	})
+}
+
+func TestSeries(t *testing.T) {
+	series := blog.Series{
+		Posts: []blog.Post{{Path: "part-1"}, {Path: "part-2"}, {Path: "part-3"}},
+	}
+
+	t.Run("It returns the position of the post in the series", func(t *testing.T) {
+		assert.Equal(t, 1, series.Part("part-1"))
+		assert.Equal(t, 3, series.Part("part-3"))
+		assert.Equal(t, 0, series.Part("other"))
+	})
+
+	t.Run("It returns the previous and next posts", func(t *testing.T) {
+		previous, ok := series.Previous("part-2")
+		assert.True(t, ok)
+		assert.Equal(t, "part-1", previous.Path)
+
+		next, ok := series.Next("part-2")
+		assert.True(t, ok)
+		assert.Equal(t, "part-3", next.Path)
+	})
+
+	t.Run("It has no previous post for the first part nor next for the last", func(t *testing.T) {
+		_, ok := series.Previous("part-1")
+		assert.False(t, ok)
+
+		_, ok = series.Next("part-3")
+		assert.False(t, ok)
+
+		_, ok = series.Next("other")
+		assert.False(t, ok)
+	})
+}
+
+func TestSlugify(t *testing.T) {
+	assert.Equal(t, "algorithms-data-structures", blog.Slugify("Algorithms & Data Structures"))
+	assert.Equal(t, "part-2", blog.Slugify("  Part 2! "))
+	assert.Equal(t, "", blog.Slugify(""))
+}
+
+func TestPostPublication(t *testing.T) {
+	now := time.Date(2022, time.May, 3, 9, 0, 0, 0, time.UTC)
+
+	t.Run("Posts in the past without a status are published", func(t *testing.T) {
+		post := blog.Post{Time: now.Add(-time.Hour)}
+
+		assert.True(t, post.IsPublished(now))
+		assert.False(t, post.IsScheduled(now))
+	})
+
+	t.Run("Posts in the future are scheduled", func(t *testing.T) {
+		post := blog.Post{Time: now.Add(time.Hour), Status: blog.PostPublished}
+
+		assert.False(t, post.IsPublished(now))
+		assert.True(t, post.IsScheduled(now))
+	})
+
+	t.Run("Drafts are never published nor scheduled", func(t *testing.T) {
+		post := blog.Post{Time: now.Add(time.Hour), Status: blog.PostDraft}
+
+		assert.False(t, post.IsPublished(now))
+		assert.False(t, post.IsScheduled(now))
+	})
+}
+
+func TestValidationError(t *testing.T) {
+	err := &blog.ValidationError{Diagnostics: []blog.Diagnostic{
+		{File: "a.md", Line: 2, Message: "first"},
+		{File: "b.md", Message: "second"},
+	}}
+
+	assert.Equal(t, "2 problem(s) found in posts:\n  a.md:2: first\n  b.md: second", err.Error())
+}
+
+func TestContentHash(t *testing.T) {
+	t.Run("It returns the same hash for the same content", func(t *testing.T) {
+		assert.Equal(t, blog.ContentHash("a", "b"), blog.ContentHash("a", "b"))
+	})
+
+	t.Run("It tells apart content split in different parts", func(t *testing.T) {
+		assert.NotEqual(t, blog.ContentHash("ab", "c"), blog.ContentHash("a", "bc"))
+	})
+}
+
+func TestNewRenderedPost(t *testing.T) {
+	t.Run("It hashes the rendered HTML along with the post", func(t *testing.T) {
+		post := blog.Post{Metadata: blog.PostMetadata{Hash: "post-hash"}}
+
+		rendered := blog.NewRenderedPost(post, "<p>Content</p>", nil)
+		rerendered := blog.NewRenderedPost(post, "<p>Content</p>\n", nil)
+		changed := blog.NewRenderedPost(blog.Post{Metadata: blog.PostMetadata{Hash: "new-hash"}}, "<p>Content</p>", nil)
+
+		assert.NotEqual(t, rendered.Hash, rerendered.Hash)
+		assert.NotEqual(t, rendered.Hash, changed.Hash)
+	})
+}
@@ -0,0 +1,47 @@
+package blog
+
+import (
+	"time"
+
+	"github.com/geisonbiazus/blog/internal/core/shared"
+)
+
+type ListPostPreviewsUseCase struct {
+	listPosts *ListPostsUseCase
+	signer    PreviewSigner
+}
+
+func NewListPostPreviewsUseCase(
+	postRepo PostRepo,
+	cache shared.Cache,
+	signer PreviewSigner,
+) *ListPostPreviewsUseCase {
+	return &ListPostPreviewsUseCase{
+		listPosts: NewListPostsUseCase(postRepo, cache),
+		signer:    signer,
+	}
+}
+
+// Run returns the drafts and scheduled posts along with the signature needed
+// to preview them.
+func (u *ListPostPreviewsUseCase) Run() ([]PostPreview, error) {
+	posts, err := u.listPosts.allPosts()
+
+	if err != nil {
+		return []PostPreview{}, err
+	}
+
+	now := time.Now()
+	previews := []PostPreview{}
+
+	for _, post := range posts {
+		if !post.IsPublished(now) {
+			previews = append(previews, PostPreview{
+				Post:      post,
+				Signature: u.signer.Sign(post.Path),
+			})
+		}
+	}
+
+	return previews, nil
+}
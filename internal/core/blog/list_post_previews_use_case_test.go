@@ -0,0 +1,51 @@
+package blog_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/geisonbiazus/blog/internal/adapters/cache"
+	"github.com/geisonbiazus/blog/internal/core/blog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListPostPreviewsUseCase(t *testing.T) {
+	setup := func() (*blog.ListPostPreviewsUseCase, *PostRepoSpy) {
+		repo := NewPostRepoSpy()
+		usecase := blog.NewListPostPreviewsUseCase(repo, cache.NewMemoryCache(), NewPreviewSignerSpy())
+		return usecase, repo
+	}
+
+	t.Run("It returns the drafts and scheduled posts with their signatures", func(t *testing.T) {
+		usecase, repo := setup()
+
+		draft := newPost()
+		draft.Path = "draft"
+		draft.Status = blog.PostDraft
+		scheduled := newPost()
+		scheduled.Path = "scheduled"
+		scheduled.Time = time.Now().Add(time.Hour)
+
+		repo.ReturnPosts = []blog.Post{scheduled, newPost(), draft}
+
+		previews, err := usecase.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, []blog.PostPreview{
+			{Post: scheduled, Signature: "signature-scheduled"},
+			{Post: draft, Signature: "signature-draft"},
+		}, previews)
+	})
+
+	t.Run("It returns the error from the repo", func(t *testing.T) {
+		usecase, repo := setup()
+
+		repo.ReturnError = errors.New("repo error")
+
+		previews, err := usecase.Run()
+
+		assert.Equal(t, []blog.PostPreview{}, previews)
+		assert.Equal(t, repo.ReturnError, err)
+	})
+}
@@ -0,0 +1,33 @@
+package blog
+
+import "github.com/geisonbiazus/blog/internal/core/shared"
+
+type ListPostsByAuthorUseCase struct {
+	listPosts *ListPostsUseCase
+}
+
+func NewListPostsByAuthorUseCase(postRepo PostRepo, cache shared.Cache) *ListPostsByAuthorUseCase {
+	return &ListPostsByAuthorUseCase{
+		listPosts: NewListPostsUseCase(postRepo, cache),
+	}
+}
+
+// Run returns the published posts of the author with the given slug, as in
+// Slugify(post.Author).
+func (u *ListPostsByAuthorUseCase) Run(authorSlug string) ([]Post, error) {
+	posts, err := u.listPosts.publishedPosts()
+
+	if err != nil {
+		return []Post{}, err
+	}
+
+	result := []Post{}
+
+	for _, post := range posts {
+		if post.AuthorSlug() == authorSlug {
+			result = append(result, post)
+		}
+	}
+
+	return result, nil
+}
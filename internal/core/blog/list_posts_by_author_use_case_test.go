@@ -0,0 +1,64 @@
+package blog_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/geisonbiazus/blog/internal/adapters/cache"
+	"github.com/geisonbiazus/blog/internal/core/blog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListPostsByAuthorUseCase(t *testing.T) {
+	setup := func() (*blog.ListPostsByAuthorUseCase, *PostRepoSpy) {
+		repo := NewPostRepoSpy()
+		usecase := blog.NewListPostsByAuthorUseCase(repo, cache.NewMemoryCache())
+		return usecase, repo
+	}
+
+	t.Run("It returns the published posts of the author with the given slug", func(t *testing.T) {
+		usecase, repo := setup()
+
+		authorPost := newPost()
+		authorPost.Path = "author-post"
+		authorPost.Author = "Geison Biazus"
+
+		draft := newPost()
+		draft.Path = "draft"
+		draft.Author = "Geison Biazus"
+		draft.Status = blog.PostDraft
+
+		otherPost := newPost()
+		otherPost.Path = "other-post"
+		otherPost.Author = "Other Author"
+
+		repo.ReturnPosts = []blog.Post{authorPost, draft, otherPost}
+
+		result, err := usecase.Run("geison-biazus")
+
+		assert.Nil(t, err)
+		assert.Equal(t, []blog.Post{authorPost}, result)
+	})
+
+	t.Run("It returns an empty slice when the author has no posts", func(t *testing.T) {
+		usecase, repo := setup()
+
+		repo.ReturnPosts = []blog.Post{newPost()}
+
+		result, err := usecase.Run("unknown")
+
+		assert.Nil(t, err)
+		assert.Equal(t, []blog.Post{}, result)
+	})
+
+	t.Run("It returns the error from the repo", func(t *testing.T) {
+		usecase, repo := setup()
+
+		repo.ReturnError = errors.New("repo error")
+
+		result, err := usecase.Run("geison-biazus")
+
+		assert.Equal(t, []blog.Post{}, result)
+		assert.Equal(t, repo.ReturnError, err)
+	})
+}
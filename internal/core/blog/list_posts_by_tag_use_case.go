@@ -0,0 +1,33 @@
+package blog
+
+import "github.com/geisonbiazus/blog/internal/core/shared"
+
+type ListPostsByTagUseCase struct {
+	listPosts *ListPostsUseCase
+}
+
+func NewListPostsByTagUseCase(postRepo PostRepo, cache shared.Cache) *ListPostsByTagUseCase {
+	// Filtering the cached list of all posts avoids adding a cache entry for
+	// every tag requested, including the ones that don't exist.
+	return &ListPostsByTagUseCase{
+		listPosts: NewListPostsUseCase(postRepo, cache),
+	}
+}
+
+func (u *ListPostsByTagUseCase) Run(tag string) ([]Post, error) {
+	posts, err := u.listPosts.publishedPosts()
+
+	if err != nil {
+		return []Post{}, err
+	}
+
+	result := []Post{}
+
+	for _, post := range posts {
+		if post.HasTag(tag) {
+			result = append(result, post)
+		}
+	}
+
+	return result, nil
+}
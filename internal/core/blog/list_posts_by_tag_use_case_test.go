@@ -0,0 +1,59 @@
+package blog_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/geisonbiazus/blog/internal/adapters/cache"
+	"github.com/geisonbiazus/blog/internal/core/blog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListPostsByTagUseCase(t *testing.T) {
+	setup := func() (*blog.ListPostsByTagUseCase, *PostRepoSpy) {
+		repo := NewPostRepoSpy()
+		usecase := blog.NewListPostsByTagUseCase(repo, cache.NewMemoryCache())
+		return usecase, repo
+	}
+
+	t.Run("It returns the posts with the given tag", func(t *testing.T) {
+		usecase, repo := setup()
+
+		goPost := newPost()
+		goPost.Path = "go-post"
+		goPost.Tags = []string{"go", "testing"}
+
+		otherPost := newPost()
+		otherPost.Path = "other-post"
+		otherPost.Tags = []string{"algorithms"}
+
+		repo.ReturnPosts = []blog.Post{goPost, otherPost}
+
+		result, err := usecase.Run("go")
+
+		assert.Nil(t, err)
+		assert.Equal(t, []blog.Post{goPost}, result)
+	})
+
+	t.Run("It returns an empty slice when no post has the tag", func(t *testing.T) {
+		usecase, repo := setup()
+
+		repo.ReturnPosts = []blog.Post{newPost()}
+
+		result, err := usecase.Run("unknown")
+
+		assert.Nil(t, err)
+		assert.Equal(t, []blog.Post{}, result)
+	})
+
+	t.Run("It returns the error from the repo", func(t *testing.T) {
+		usecase, repo := setup()
+
+		repo.ReturnError = errors.New("repo error")
+
+		result, err := usecase.Run("go")
+
+		assert.Equal(t, []blog.Post{}, result)
+		assert.Equal(t, repo.ReturnError, err)
+	})
+}
@@ -1,57 +1,77 @@
 package blog
 
-import "github.com/geisonbiazus/blog/internal/core/shared"
+import (
+	"time"
+
+	"github.com/geisonbiazus/blog/internal/core/shared"
+)
 
 type ListPostsUseCase struct {
 	postRepo PostRepo
-	renderer Renderer
 	cache    shared.Cache
 }
 
-func NewListPostsUseCase(
-	postRepo PostRepo,
-	renderer Renderer,
-	cache shared.Cache,
-) *ListPostsUseCase {
+func NewListPostsUseCase(postRepo PostRepo, cache shared.Cache) *ListPostsUseCase {
 	return &ListPostsUseCase{
 		postRepo: postRepo,
-		renderer: renderer,
 		cache:    cache,
 	}
 }
 
-const cacheKey = "all-posts"
+// Run returns a page of the published posts. The limit falls back to
+// DefaultPostsPerPage when not given and can't be greater than
+// MaxPostsPerPage.
+func (u *ListPostsUseCase) Run(page, limit int) (PostPage, error) {
+	posts, err := u.publishedPosts()
 
-func (u *ListPostsUseCase) Run() ([]RenderedPost, error) {
-	result, err := u.cache.Do(cacheKey, func() (interface{}, error) {
-		return u.run()
-	}, shared.NeverExpire)
+	if err != nil {
+		return PostPage{}, err
+	}
 
-	return result.([]RenderedPost), err
-}
+	if limit < 1 {
+		limit = DefaultPostsPerPage
+	}
+
+	if limit > MaxPostsPerPage {
+		limit = MaxPostsPerPage
+	}
 
-func (u *ListPostsUseCase) run() ([]RenderedPost, error) {
-	posts, err := u.postRepo.GetAllPosts()
+	result := PostPage{Page: page, Limit: limit, TotalPosts: len(posts)}
 
-	if err != nil {
-		return []RenderedPost{}, err
+	if page < 1 || (page > 1 && page > result.TotalPages()) {
+		return PostPage{}, ErrPageNotFound
 	}
 
-	return u.renderPosts(posts)
+	start := (page - 1) * limit
+	end := min(start+limit, len(posts))
+	result.Posts = posts[start:end]
+
+	return result, nil
 }
 
-func (u *ListPostsUseCase) renderPosts(posts []Post) ([]RenderedPost, error) {
-	renderedPosts := []RenderedPost{}
+// publishedPosts returns every published post. The list is cached until the
+// next scheduled post goes live so it shows up without restarting the server.
+func (u *ListPostsUseCase) publishedPosts() ([]Post, error) {
+	posts, err := u.allPosts()
 
-	for _, post := range posts {
-		html, err := u.renderer.Render(post.Markdown)
+	if err != nil {
+		return posts, err
+	}
 
-		if err != nil {
-			return []RenderedPost{}, err
-		}
+	now := time.Now()
 
-		renderedPosts = append(renderedPosts, RenderedPost{Post: post, HTML: html})
+	return cachedValue(u.cache, publishedPostsCacheKey, func() ([]Post, error) {
+		return publishedPosts(posts, now), nil
+	}, untilNextPublication(posts, now))
+}
+
+// allPosts returns every post, including drafts and scheduled ones.
+func (u *ListPostsUseCase) allPosts() ([]Post, error) {
+	posts, err := cachedValue(u.cache, allPostsCacheKey, u.postRepo.GetAllPosts, shared.NeverExpire)
+
+	if err != nil {
+		return []Post{}, err
 	}
 
-	return renderedPosts, nil
+	return posts, nil
 }
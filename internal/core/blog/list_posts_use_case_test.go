@@ -2,78 +2,166 @@ package blog_test
 
 import (
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/geisonbiazus/blog/internal/adapters/cache"
 	"github.com/geisonbiazus/blog/internal/core/blog"
+	"github.com/geisonbiazus/blog/internal/core/shared"
 	"github.com/stretchr/testify/assert"
 )
 
 type listPostsUseCaseFixture struct {
-	usecase  *blog.ListPostsUseCase
-	repo     *PostRepoSpy
-	renderer *RendererSpy
+	usecase *blog.ListPostsUseCase
+	repo    *PostRepoSpy
 }
 
 func TestTestListPostsUseCase(t *testing.T) {
 	setup := func() *listPostsUseCaseFixture {
 		repo := NewPostRepoSpy()
-		renderer := NewRendererSpy()
 		cache := cache.NewMemoryCache()
-		usecase := blog.NewListPostsUseCase(repo, renderer, cache)
+		usecase := blog.NewListPostsUseCase(repo, cache)
 		return &listPostsUseCaseFixture{
-			usecase:  usecase,
-			repo:     repo,
-			renderer: renderer,
+			usecase: usecase,
+			repo:    repo,
 		}
 	}
 
-	t.Run("Given no post exists, it returns an empty slice", func(t *testing.T) {
+	newPosts := func(count int) []blog.Post {
+		posts := []blog.Post{}
+		for i := 1; i <= count; i++ {
+			post := newPost()
+			post.Path = fmt.Sprintf("post-%d", i)
+			posts = append(posts, post)
+		}
+		return posts
+	}
+
+	t.Run("Given no post exists, it returns an empty first page", func(t *testing.T) {
 		f := setup()
 
-		posts, err := f.usecase.Run()
+		page, err := f.usecase.Run(1, 10)
 
-		assert.Equal(t, []blog.RenderedPost{}, posts)
+		assert.Equal(t, blog.PostPage{Posts: []blog.Post{}, Page: 1, Limit: 10}, page)
 		assert.Nil(t, err)
 	})
 
-	t.Run("Given some posts, it renders and returns them", func(t *testing.T) {
+	t.Run("Given some posts, it returns them without rendering", func(t *testing.T) {
 		f := setup()
 
-		post := newPost()
-		renderedPost := blog.RenderedPost{Post: post, HTML: "Rendered post"}
-		posts := []blog.Post{post}
-		renderedPosts := []blog.RenderedPost{renderedPost}
+		posts := newPosts(2)
+		f.repo.ReturnPosts = posts
+
+		page, err := f.usecase.Run(1, 10)
+
+		assert.Equal(t, blog.PostPage{Posts: posts, Page: 1, Limit: 10, TotalPosts: 2}, page)
+		assert.Nil(t, err)
+	})
+
+	t.Run("It returns the posts of the given page", func(t *testing.T) {
+		f := setup()
 
+		posts := newPosts(5)
 		f.repo.ReturnPosts = posts
-		f.renderer.ReturnRenderedContent = renderedPost.HTML
 
-		result, err := f.usecase.Run()
+		page, err := f.usecase.Run(2, 2)
+
+		assert.Nil(t, err)
+		assert.Equal(t, blog.PostPage{Posts: posts[2:4], Page: 2, Limit: 2, TotalPosts: 5}, page)
+
+		page, err = f.usecase.Run(3, 2)
 
-		assert.Equal(t, renderedPosts, result)
 		assert.Nil(t, err)
+		assert.Equal(t, posts[4:], page.Posts)
 	})
 
-	t.Run("Given an error is returned form the repo, it returns the error", func(t *testing.T) {
+	t.Run("It uses the default limit when none is given and caps it to the maximum", func(t *testing.T) {
 		f := setup()
 
-		f.repo.ReturnError = errors.New("Repo error")
+		f.repo.ReturnPosts = newPosts(blog.MaxPostsPerPage + 1)
 
-		result, err := f.usecase.Run()
+		page, _ := f.usecase.Run(1, 0)
 
-		assert.Equal(t, []blog.RenderedPost{}, result)
-		assert.Equal(t, f.repo.ReturnError, err)
+		assert.Equal(t, blog.DefaultPostsPerPage, page.Limit)
+		assert.Len(t, page.Posts, blog.DefaultPostsPerPage)
+
+		page, _ = f.usecase.Run(1, blog.MaxPostsPerPage+1)
+
+		assert.Equal(t, blog.MaxPostsPerPage, page.Limit)
+		assert.Len(t, page.Posts, blog.MaxPostsPerPage)
+	})
+
+	t.Run("It returns page not found for pages out of range", func(t *testing.T) {
+		f := setup()
+
+		f.repo.ReturnPosts = newPosts(2)
+
+		_, err := f.usecase.Run(0, 2)
+		assert.Equal(t, blog.ErrPageNotFound, err)
+
+		_, err = f.usecase.Run(2, 2)
+		assert.Equal(t, blog.ErrPageNotFound, err)
 	})
 
-	t.Run("Given an error is returned form the renderer, it returns the error", func(t *testing.T) {
+	t.Run("It hides drafts and scheduled posts", func(t *testing.T) {
 		f := setup()
 
-		f.repo.ReturnPosts = []blog.Post{newPost()}
-		f.renderer.ReturnError = errors.New("Renderer error")
+		published := newPost()
+		draft := newPost()
+		draft.Path = "draft"
+		draft.Status = blog.PostDraft
+		scheduled := newPost()
+		scheduled.Path = "scheduled"
+		scheduled.Time = time.Now().Add(time.Hour)
 
-		result, err := f.usecase.Run()
+		f.repo.ReturnPosts = []blog.Post{scheduled, draft, published}
 
-		assert.Equal(t, []blog.RenderedPost{}, result)
-		assert.Equal(t, f.renderer.ReturnError, err)
+		page, err := f.usecase.Run(1, 10)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []blog.Post{published}, page.Posts)
+		assert.Equal(t, 1, page.TotalPosts)
+	})
+
+	t.Run("It caches the published posts until the next scheduled publication", func(t *testing.T) {
+		repo := NewPostRepoSpy()
+		cacheSpy := NewCacheSpy(cache.NewMemoryCache())
+		usecase := blog.NewListPostsUseCase(repo, cacheSpy)
+
+		scheduled := newPost()
+		scheduled.Time = time.Now().Add(time.Hour)
+		later := newPost()
+		later.Time = time.Now().Add(2 * time.Hour)
+
+		repo.ReturnPosts = []blog.Post{later, scheduled, newPost()}
+
+		usecase.Run(1, 10)
+
+		assert.Equal(t, shared.NeverExpire, cacheSpy.ReceivedExpiresIn["posts:all"])
+		assert.InDelta(t, time.Hour, cacheSpy.ReceivedExpiresIn["posts:published"], float64(time.Second))
+	})
+
+	t.Run("It caches the published posts forever when nothing is scheduled", func(t *testing.T) {
+		repo := NewPostRepoSpy()
+		cacheSpy := NewCacheSpy(cache.NewMemoryCache())
+		usecase := blog.NewListPostsUseCase(repo, cacheSpy)
+
+		repo.ReturnPosts = []blog.Post{newPost()}
+
+		usecase.Run(1, 10)
+
+		assert.Equal(t, shared.NeverExpire, cacheSpy.ReceivedExpiresIn["posts:published"])
+	})
+
+	t.Run("Given an error is returned form the repo, it returns the error", func(t *testing.T) {
+		f := setup()
+
+		f.repo.ReturnError = errors.New("Repo error")
+
+		page, err := f.usecase.Run(1, 10)
+
+		assert.Equal(t, blog.PostPage{}, page)
+		assert.Equal(t, f.repo.ReturnError, err)
 	})
 }
@@ -0,0 +1,57 @@
+package blog
+
+import (
+	"time"
+
+	"github.com/geisonbiazus/blog/internal/core/shared"
+)
+
+type ListRenderedPostsUseCase struct {
+	listPosts *ListPostsUseCase
+	renderer  Renderer
+	cache     shared.Cache
+}
+
+func NewListRenderedPostsUseCase(
+	postRepo PostRepo,
+	renderer Renderer,
+	cache shared.Cache,
+) *ListRenderedPostsUseCase {
+	return &ListRenderedPostsUseCase{
+		listPosts: NewListPostsUseCase(postRepo, cache),
+		renderer:  renderer,
+		cache:     cache,
+	}
+}
+
+// Run returns every published post with its content rendered, e.g. to be
+// included in the feed. Prefer ListPostsUseCase when the content isn't needed.
+func (u *ListRenderedPostsUseCase) Run() ([]RenderedPost, error) {
+	posts, err := u.listPosts.allPosts()
+
+	if err != nil {
+		return []RenderedPost{}, err
+	}
+
+	now := time.Now()
+
+	return cachedValue(u.cache, renderedPostsCacheKey, func() ([]RenderedPost, error) {
+		return renderPosts(u.renderer, publishedPosts(posts, now))
+	}, untilNextPublication(posts, now))
+}
+
+func renderPosts(renderer Renderer, posts []Post) ([]RenderedPost, error) {
+	renderedPosts := []RenderedPost{}
+
+	for _, post := range posts {
+		html, headings, err := renderer.Render(post.Markdown)
+
+		if err != nil {
+			return []RenderedPost{}, err
+		}
+
+		renderedPosts = append(renderedPosts, NewRenderedPost(post, html, headings))
+	}
+
+	return renderedPosts, nil
+}
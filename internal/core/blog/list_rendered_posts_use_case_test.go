@@ -0,0 +1,102 @@
+package blog_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/geisonbiazus/blog/internal/adapters/cache"
+	"github.com/geisonbiazus/blog/internal/core/blog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListRenderedPostsUseCase(t *testing.T) {
+	type fixture struct {
+		usecase  *blog.ListRenderedPostsUseCase
+		repo     *PostRepoSpy
+		renderer *RendererSpy
+		cache    *CacheSpy
+	}
+
+	setup := func() *fixture {
+		repo := NewPostRepoSpy()
+		renderer := NewRendererSpy()
+		cache := NewCacheSpy(cache.NewMemoryCache())
+		usecase := blog.NewListRenderedPostsUseCase(repo, renderer, cache)
+		return &fixture{usecase: usecase, repo: repo, renderer: renderer, cache: cache}
+	}
+
+	t.Run("Given no post exists, it returns an empty slice", func(t *testing.T) {
+		f := setup()
+
+		posts, err := f.usecase.Run()
+
+		assert.Equal(t, []blog.RenderedPost{}, posts)
+		assert.Nil(t, err)
+	})
+
+	t.Run("It renders and returns the published posts", func(t *testing.T) {
+		f := setup()
+
+		post := newPost()
+		draft := newPost()
+		draft.Status = blog.PostDraft
+		f.repo.ReturnPosts = []blog.Post{post, draft}
+		f.renderer.ReturnRenderedContent = "Rendered post"
+
+		result, err := f.usecase.Run()
+
+		assert.Equal(t, []blog.RenderedPost{blog.NewRenderedPost(post, "Rendered post", nil)}, result)
+		assert.Nil(t, err)
+	})
+
+	t.Run("It caches the rendered posts until the next scheduled publication", func(t *testing.T) {
+		f := setup()
+
+		scheduled := newPost()
+		scheduled.Time = time.Now().Add(time.Hour)
+		f.repo.ReturnPosts = []blog.Post{scheduled, newPost()}
+
+		f.usecase.Run()
+
+		assert.InDelta(t, time.Hour, f.cache.ReceivedExpiresIn["posts:rendered"], float64(time.Second))
+	})
+
+	t.Run("It keeps the rendered posts apart from a post with the same path", func(t *testing.T) {
+		f := setup()
+		post := newPost()
+		post.Path = "rendered-posts"
+		f.repo.ReturnPost = post
+		f.repo.ReturnPosts = []blog.Post{post}
+
+		_, err := f.usecase.Run()
+		renderedPost, viewErr := blog.NewViewPostUseCase(f.repo, f.renderer, f.cache).Run("rendered-posts")
+
+		assert.Nil(t, err)
+		assert.Nil(t, viewErr)
+		assert.Equal(t, post, renderedPost.Post)
+	})
+
+	t.Run("Given an error is returned form the repo, it returns the error", func(t *testing.T) {
+		f := setup()
+
+		f.repo.ReturnError = errors.New("Repo error")
+
+		result, err := f.usecase.Run()
+
+		assert.Equal(t, []blog.RenderedPost{}, result)
+		assert.Equal(t, f.repo.ReturnError, err)
+	})
+
+	t.Run("Given an error is returned form the renderer, it returns the error", func(t *testing.T) {
+		f := setup()
+
+		f.repo.ReturnPosts = []blog.Post{newPost()}
+		f.renderer.ReturnError = errors.New("Renderer error")
+
+		result, err := f.usecase.Run()
+
+		assert.Equal(t, []blog.RenderedPost{}, result)
+		assert.Equal(t, f.renderer.ReturnError, err)
+	})
+}
@@ -0,0 +1,24 @@
+package blog
+
+import "github.com/geisonbiazus/blog/internal/core/shared"
+
+type ListTagsUseCase struct {
+	listPosts *ListPostsUseCase
+}
+
+func NewListTagsUseCase(postRepo PostRepo, cache shared.Cache) *ListTagsUseCase {
+	return &ListTagsUseCase{
+		listPosts: NewListPostsUseCase(postRepo, cache),
+	}
+}
+
+// Run returns the tags of the published posts with how many posts use each.
+func (u *ListTagsUseCase) Run() ([]TagCount, error) {
+	posts, err := u.listPosts.publishedPosts()
+
+	if err != nil {
+		return []TagCount{}, err
+	}
+
+	return CountTags(posts), nil
+}
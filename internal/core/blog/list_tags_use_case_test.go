@@ -0,0 +1,45 @@
+package blog_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/geisonbiazus/blog/internal/adapters/cache"
+	"github.com/geisonbiazus/blog/internal/core/blog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListTagsUseCase(t *testing.T) {
+	setup := func() (*blog.ListTagsUseCase, *PostRepoSpy) {
+		repo := NewPostRepoSpy()
+		usecase := blog.NewListTagsUseCase(repo, cache.NewMemoryCache())
+		return usecase, repo
+	}
+
+	t.Run("It counts the tags of the published posts", func(t *testing.T) {
+		usecase, repo := setup()
+
+		post := newPost()
+		post.Tags = []string{"go", "testing"}
+		draft := newPost()
+		draft.Tags = []string{"go", "drafts"}
+		draft.Status = blog.PostDraft
+		repo.ReturnPosts = []blog.Post{post, draft}
+
+		tags, err := usecase.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, []blog.TagCount{{Tag: "go", Count: 1}, {Tag: "testing", Count: 1}}, tags)
+	})
+
+	t.Run("It returns the error from the repo", func(t *testing.T) {
+		usecase, repo := setup()
+
+		repo.ReturnError = errors.New("repo error")
+
+		tags, err := usecase.Run()
+
+		assert.Equal(t, []blog.TagCount{}, tags)
+		assert.Equal(t, repo.ReturnError, err)
+	})
+}
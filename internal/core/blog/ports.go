@@ -5,6 +5,21 @@ type PostRepo interface {
 	GetAllPosts() ([]Post, error)
 }
 
+// Renderer converts the Markdown content of a post to HTML, returning the
+// headings found in it as a tree.
 type Renderer interface {
-	Render(content string) (string, error)
+	Render(content string) (string, []Heading, error)
+}
+
+type PreviewSigner interface {
+	Sign(value string) string
+	Verify(value, signature string) bool
+}
+
+// PostIndex is a full-text index of the published posts. Index replaces the
+// indexed posts with the given ones and Search returns the matches ordered by
+// relevance.
+type PostIndex interface {
+	Index(posts []Post) error
+	Search(query string) ([]SearchMatch, error)
 }
@@ -0,0 +1,28 @@
+package blog
+
+import "github.com/geisonbiazus/blog/internal/core/shared"
+
+type PreviewPostUseCase struct {
+	viewPost *ViewPostUseCase
+	signer   PreviewSigner
+}
+
+func NewPreviewPostUseCase(
+	postRepo PostRepo,
+	renderer Renderer,
+	cache shared.Cache,
+	signer PreviewSigner,
+) *PreviewPostUseCase {
+	return &PreviewPostUseCase{
+		viewPost: NewViewPostUseCase(postRepo, renderer, cache),
+		signer:   signer,
+	}
+}
+
+func (u *PreviewPostUseCase) Run(path, signature string) (RenderedPost, error) {
+	if !u.signer.Verify(path, signature) {
+		return RenderedPost{}, ErrInvalidPreviewSignature
+	}
+
+	return u.viewPost.renderedPost(path)
+}
@@ -0,0 +1,73 @@
+package blog_test
+
+import (
+	"testing"
+
+	"github.com/geisonbiazus/blog/internal/adapters/cache"
+	"github.com/geisonbiazus/blog/internal/core/blog"
+	"github.com/stretchr/testify/assert"
+)
+
+type previewPostUseCaseFixture struct {
+	usecase  *blog.PreviewPostUseCase
+	repo     *PostRepoSpy
+	renderer *RendererSpy
+	signer   *PreviewSignerSpy
+}
+
+func TestPreviewPostUseCase(t *testing.T) {
+	setup := func() *previewPostUseCaseFixture {
+		repo := NewPostRepoSpy()
+		renderer := NewRendererSpy()
+		signer := NewPreviewSignerSpy()
+		usecase := blog.NewPreviewPostUseCase(repo, renderer, cache.NewMemoryCache(), signer)
+
+		return &previewPostUseCaseFixture{
+			usecase:  usecase,
+			repo:     repo,
+			renderer: renderer,
+			signer:   signer,
+		}
+	}
+
+	t.Run("It returns the draft when the signature is valid", func(t *testing.T) {
+		f := setup()
+
+		draft := newPost()
+		draft.Status = blog.PostDraft
+		f.repo.ReturnPost = draft
+		f.renderer.ReturnRenderedContent = "Rendered content"
+		f.signer.ReturnValid = true
+
+		renderedPost, err := f.usecase.Run("path", "signature")
+
+		assert.Nil(t, err)
+		assert.Equal(t, "path", f.signer.ReceivedValue)
+		assert.Equal(t, "signature", f.signer.ReceivedSignature)
+		assert.Equal(t, blog.NewRenderedPost(draft, "Rendered content", nil), renderedPost)
+	})
+
+	t.Run("It returns ErrInvalidPreviewSignature when the signature is invalid", func(t *testing.T) {
+		f := setup()
+
+		f.repo.ReturnPost = newPost()
+		f.signer.ReturnValid = false
+
+		renderedPost, err := f.usecase.Run("path", "wrong")
+
+		assert.Equal(t, blog.RenderedPost{}, renderedPost)
+		assert.Equal(t, blog.ErrInvalidPreviewSignature, err)
+		assert.Equal(t, "", f.repo.ReceivedPath)
+	})
+
+	t.Run("It returns the error from the repo", func(t *testing.T) {
+		f := setup()
+
+		f.repo.ReturnError = blog.ErrPostNotFound
+		f.signer.ReturnValid = true
+
+		_, err := f.usecase.Run("path", "signature")
+
+		assert.Equal(t, blog.ErrPostNotFound, err)
+	})
+}
@@ -0,0 +1,203 @@
+package blog
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/geisonbiazus/blog/internal/core/shared"
+)
+
+type RelatedPostsUseCase struct {
+	listPosts *ListPostsUseCase
+	cache     shared.Cache
+}
+
+func NewRelatedPostsUseCase(postRepo PostRepo, cache shared.Cache) *RelatedPostsUseCase {
+	return &RelatedPostsUseCase{
+		listPosts: NewListPostsUseCase(postRepo, cache),
+		cache:     cache,
+	}
+}
+
+// Run returns up to limit published posts related to the post with the given
+// path, most related first.
+func (u *RelatedPostsUseCase) Run(path string, limit int) ([]Post, error) {
+	related, err := u.relatedPosts()
+
+	if err != nil {
+		return []Post{}, err
+	}
+
+	posts, ok := related[path]
+
+	if !ok {
+		return []Post{}, nil
+	}
+
+	if len(posts) > limit {
+		posts = posts[:limit]
+	}
+
+	return posts, nil
+}
+
+// relatedPosts scores every pair of published posts once, caching the result
+// until the cached list of posts is refreshed.
+func (u *RelatedPostsUseCase) relatedPosts() (map[string][]Post, error) {
+	allPosts, err := u.listPosts.allPosts()
+
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	related, err := cachedValue(u.cache, relatedPostsCacheKey, func() (map[string][]Post, error) {
+		return findRelatedPosts(publishedPosts(allPosts, now)), nil
+	}, untilNextPublication(allPosts, now))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return related, nil
+}
+
+const sharedTagScore = 1.0
+const sameSeriesScore = 2.0
+
+type scoredPost struct {
+	post  Post
+	score float64
+}
+
+// findRelatedPosts maps the path of each post to the other posts sorted by how
+// related they are. Each shared tag and being in the same series add to the
+// score, as does the similarity of their content from 0 to 1. Ties keep the
+// original order of the posts.
+func findRelatedPosts(posts []Post) map[string][]Post {
+	vectors := termVectors(posts)
+	result := map[string][]Post{}
+
+	for i, post := range posts {
+		scored := []scoredPost{}
+
+		for j, other := range posts {
+			if i == j {
+				continue
+			}
+
+			score := relatedness(post, other) + cosineSimilarity(vectors[i], vectors[j])
+
+			if score > 0 {
+				scored = append(scored, scoredPost{post: other, score: score})
+			}
+		}
+
+		sort.SliceStable(scored, func(a, b int) bool {
+			return scored[a].score > scored[b].score
+		})
+
+		related := []Post{}
+
+		for _, s := range scored {
+			related = append(related, s.post)
+		}
+
+		result[post.Path] = related
+	}
+
+	return result
+}
+
+func relatedness(post, other Post) float64 {
+	score := 0.0
+
+	for _, tag := range post.Tags {
+		if other.HasTag(tag) {
+			score += sharedTagScore
+		}
+	}
+
+	if post.Series != "" && post.SeriesSlug() == other.SeriesSlug() {
+		score += sameSeriesScore
+	}
+
+	return score
+}
+
+type termVector map[string]float64
+
+// termVectors weights the terms of each post by TF-IDF, so words used by every
+// post don't make them similar.
+func termVectors(posts []Post) []termVector {
+	frequencies := []map[string]int{}
+	documentFrequency := map[string]int{}
+
+	for _, post := range posts {
+		terms := termFrequency(post.Markdown)
+		frequencies = append(frequencies, terms)
+
+		for term := range terms {
+			documentFrequency[term]++
+		}
+	}
+
+	vectors := []termVector{}
+
+	for _, terms := range frequencies {
+		vector := termVector{}
+
+		for term, count := range terms {
+			idf := math.Log(float64(len(posts)) / float64(documentFrequency[term]))
+
+			if idf > 0 {
+				vector[term] = float64(count) * idf
+			}
+		}
+
+		vectors = append(vectors, vector)
+	}
+
+	return vectors
+}
+
+const minTermLength = 3
+
+func termFrequency(markdown string) map[string]int {
+	terms := map[string]int{}
+
+	words := strings.FieldsFunc(strings.ToLower(PlainText(markdown)), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	for _, word := range words {
+		if len([]rune(word)) >= minTermLength {
+			terms[word]++
+		}
+	}
+
+	return terms
+}
+
+func cosineSimilarity(a, b termVector) float64 {
+	dot, normA, normB := 0.0, 0.0, 0.0
+
+	for term, weight := range a {
+		dot += weight * b[term]
+		normA += weight * weight
+	}
+
+	for _, weight := range b {
+		normB += weight * weight
+	}
+
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
@@ -0,0 +1,149 @@
+package blog_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/geisonbiazus/blog/internal/adapters/cache"
+	"github.com/geisonbiazus/blog/internal/core/blog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRelatedPostsUseCase(t *testing.T) {
+	type fixture struct {
+		usecase *blog.RelatedPostsUseCase
+		repo    *PostRepoSpy
+		cache   *CacheSpy
+	}
+
+	setup := func() *fixture {
+		repo := NewPostRepoSpy()
+		cache := NewCacheSpy(cache.NewMemoryCache())
+		usecase := blog.NewRelatedPostsUseCase(repo, cache)
+		return &fixture{usecase: usecase, repo: repo, cache: cache}
+	}
+
+	newPostWithTags := func(path string, tags ...string) blog.Post {
+		post := newPost()
+		post.Path = path
+		post.Tags = tags
+		return post
+	}
+
+	t.Run("It returns the posts sharing more tags first", func(t *testing.T) {
+		f := setup()
+		post := newPostWithTags("post", "go", "testing", "tdd")
+		oneTag := newPostWithTags("one-tag", "go")
+		twoTags := newPostWithTags("two-tags", "testing", "tdd")
+		unrelated := newPostWithTags("unrelated", "ruby")
+		f.repo.ReturnPosts = []blog.Post{post, oneTag, twoTags, unrelated}
+
+		related, err := f.usecase.Run("post", 5)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []blog.Post{twoTags, oneTag}, related)
+	})
+
+	t.Run("It favors posts of the same series", func(t *testing.T) {
+		f := setup()
+		post := newPostWithTags("post", "go")
+		sameTag := newPostWithTags("same-tag", "go")
+		sameSeries := newPostWithTags("same-series")
+		post.Series = "Data Structures"
+		sameSeries.Series = "Data Structures"
+		f.repo.ReturnPosts = []blog.Post{post, sameTag, sameSeries}
+
+		related, _ := f.usecase.Run("post", 5)
+
+		assert.Equal(t, []blog.Post{sameSeries, sameTag}, related)
+	})
+
+	t.Run("It finds posts with similar content", func(t *testing.T) {
+		f := setup()
+		post := newPostWithTags("post")
+		post.Markdown = "Implementing a binary search tree in Go"
+		similar := newPostWithTags("similar")
+		similar.Markdown = "Balancing a binary search tree"
+		different := newPostWithTags("different")
+		different.Markdown = "Deploying a web server with Docker"
+		f.repo.ReturnPosts = []blog.Post{post, different, similar}
+
+		related, _ := f.usecase.Run("post", 5)
+
+		assert.Equal(t, []blog.Post{similar}, related)
+	})
+
+	t.Run("It returns at most the given number of posts", func(t *testing.T) {
+		f := setup()
+		f.repo.ReturnPosts = []blog.Post{
+			newPostWithTags("post", "go"),
+			newPostWithTags("post-1", "go"),
+			newPostWithTags("post-2", "go"),
+			newPostWithTags("post-3", "go"),
+		}
+
+		related, _ := f.usecase.Run("post", 2)
+
+		assert.Equal(t, []string{"post-1", "post-2"}, paths(related))
+	})
+
+	t.Run("It doesn't recommend drafts and scheduled posts", func(t *testing.T) {
+		f := setup()
+		draft := newPostWithTags("draft", "go")
+		draft.Status = blog.PostDraft
+		scheduled := newPostWithTags("scheduled", "go")
+		scheduled.Time = time.Now().Add(time.Hour)
+		f.repo.ReturnPosts = []blog.Post{newPostWithTags("post", "go"), draft, scheduled}
+
+		related, _ := f.usecase.Run("post", 5)
+
+		assert.Equal(t, []blog.Post{}, related)
+		assert.InDelta(t, time.Hour, f.cache.ReceivedExpiresIn["posts:related"], float64(time.Minute))
+	})
+
+	t.Run("It keeps the related posts apart from a post with the same path", func(t *testing.T) {
+		f := setup()
+		post := newPostWithTags("related-posts", "go")
+		f.repo.ReturnPost = post
+		f.repo.ReturnPosts = []blog.Post{post, newPostWithTags("other", "go")}
+
+		related, err := f.usecase.Run("related-posts", 5)
+		renderedPost, viewErr := blog.NewViewPostUseCase(f.repo, NewRendererSpy(), f.cache).Run("related-posts")
+
+		assert.Nil(t, err)
+		assert.Nil(t, viewErr)
+		assert.Len(t, related, 1)
+		assert.Equal(t, post, renderedPost.Post)
+	})
+
+	t.Run("Given an unknown post, it returns no posts", func(t *testing.T) {
+		f := setup()
+		f.repo.ReturnPosts = []blog.Post{newPostWithTags("post", "go")}
+
+		related, err := f.usecase.Run("unknown", 5)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []blog.Post{}, related)
+	})
+
+	t.Run("Given an error is returned from the repo, it returns the error", func(t *testing.T) {
+		f := setup()
+		f.repo.ReturnError = errors.New("repo error")
+
+		related, err := f.usecase.Run("post", 5)
+
+		assert.Equal(t, []blog.Post{}, related)
+		assert.Equal(t, f.repo.ReturnError, err)
+	})
+}
+
+func paths(posts []blog.Post) []string {
+	result := []string{}
+
+	for _, post := range posts {
+		result = append(result, post.Path)
+	}
+
+	return result
+}
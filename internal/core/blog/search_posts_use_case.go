@@ -0,0 +1,82 @@
+package blog
+
+import (
+	"strings"
+	"time"
+
+	"github.com/geisonbiazus/blog/internal/core/shared"
+)
+
+type SearchPostsUseCase struct {
+	listPosts *ListPostsUseCase
+	index     PostIndex
+	cache     shared.Cache
+}
+
+func NewSearchPostsUseCase(
+	postRepo PostRepo,
+	cache shared.Cache,
+	index PostIndex,
+) *SearchPostsUseCase {
+	return &SearchPostsUseCase{
+		listPosts: NewListPostsUseCase(postRepo, cache),
+		index:     index,
+		cache:     cache,
+	}
+}
+
+// Run returns the published posts matching the query, most relevant first.
+func (u *SearchPostsUseCase) Run(query string) ([]SearchResult, error) {
+	if strings.TrimSpace(query) == "" {
+		return []SearchResult{}, nil
+	}
+
+	posts, err := u.indexedPosts()
+
+	if err != nil {
+		return []SearchResult{}, err
+	}
+
+	matches, err := u.index.Search(query)
+
+	if err != nil {
+		return []SearchResult{}, err
+	}
+
+	return toSearchResults(matches, posts), nil
+}
+
+// indexedPosts returns the published posts, rebuilding the index whenever the
+// cached list of posts is refreshed so both always hold the same posts.
+func (u *SearchPostsUseCase) indexedPosts() ([]Post, error) {
+	allPosts, err := u.listPosts.allPosts()
+
+	if err != nil {
+		return []Post{}, err
+	}
+
+	now := time.Now()
+
+	return cachedValue(u.cache, searchIndexCacheKey, func() ([]Post, error) {
+		posts := publishedPosts(allPosts, now)
+		return posts, u.index.Index(posts)
+	}, untilNextPublication(allPosts, now))
+}
+
+func toSearchResults(matches []SearchMatch, posts []Post) []SearchResult {
+	postsByPath := map[string]Post{}
+
+	for _, post := range posts {
+		postsByPath[post.Path] = post
+	}
+
+	results := []SearchResult{}
+
+	for _, match := range matches {
+		if post, ok := postsByPath[match.Path]; ok {
+			results = append(results, SearchResult{Post: post, Score: match.Score, Snippet: match.Snippet})
+		}
+	}
+
+	return results
+}
@@ -0,0 +1,142 @@
+package blog_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/geisonbiazus/blog/internal/adapters/cache"
+	"github.com/geisonbiazus/blog/internal/core/blog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchPostsUseCase(t *testing.T) {
+	type fixture struct {
+		usecase *blog.SearchPostsUseCase
+		repo    *PostRepoSpy
+		index   *PostIndexSpy
+		cache   *CacheSpy
+	}
+
+	setup := func() *fixture {
+		repo := NewPostRepoSpy()
+		index := NewPostIndexSpy()
+		cache := NewCacheSpy(cache.NewMemoryCache())
+		usecase := blog.NewSearchPostsUseCase(repo, cache, index)
+		return &fixture{usecase: usecase, repo: repo, index: index, cache: cache}
+	}
+
+	newPostWithPath := func(path string) blog.Post {
+		post := newPost()
+		post.Path = path
+		return post
+	}
+
+	snippet := blog.Snippet{{Text: "matching", Highlighted: true}, {Text: " text"}}
+
+	t.Run("It indexes the published posts and returns the matches", func(t *testing.T) {
+		f := setup()
+		post1 := newPostWithPath("post-1")
+		post2 := newPostWithPath("post-2")
+		draft := newPostWithPath("draft")
+		draft.Status = blog.PostDraft
+		f.repo.ReturnPosts = []blog.Post{post1, post2, draft}
+		f.index.ReturnMatches = []blog.SearchMatch{
+			{Path: "post-2", Score: 2, Snippet: snippet},
+			{Path: "post-1", Score: 1},
+		}
+
+		results, err := f.usecase.Run("query")
+
+		assert.Nil(t, err)
+		assert.Equal(t, []blog.Post{post1, post2}, f.index.ReceivedPosts)
+		assert.Equal(t, "query", f.index.ReceivedQuery)
+		assert.Equal(t, []blog.SearchResult{
+			{Post: post2, Score: 2, Snippet: snippet},
+			{Post: post1, Score: 1},
+		}, results)
+	})
+
+	t.Run("It builds the index only once while the posts are cached", func(t *testing.T) {
+		f := setup()
+		f.repo.ReturnPosts = []blog.Post{newPostWithPath("post-1")}
+
+		f.usecase.Run("query")
+		f.usecase.Run("other query")
+
+		assert.Equal(t, 1, f.index.IndexCalls)
+	})
+
+	t.Run("It keeps the index apart from a post with the same path", func(t *testing.T) {
+		f := setup()
+		post := newPostWithPath("search-index")
+		f.repo.ReturnPost = post
+		f.repo.ReturnPosts = []blog.Post{post}
+		viewPost := blog.NewViewPostUseCase(f.repo, NewRendererSpy(), f.cache)
+
+		_, viewErr := viewPost.Run("search-index")
+		_, searchErr := f.usecase.Run("query")
+		renderedPost, _ := viewPost.Run("search-index")
+
+		assert.Nil(t, viewErr)
+		assert.Nil(t, searchErr)
+		assert.Equal(t, 1, f.index.IndexCalls)
+		assert.Equal(t, post, renderedPost.Post)
+	})
+
+	t.Run("It rebuilds the index when the next scheduled post is published", func(t *testing.T) {
+		f := setup()
+		scheduled := newPostWithPath("scheduled")
+		scheduled.Time = time.Now().Add(time.Hour)
+		f.repo.ReturnPosts = []blog.Post{newPostWithPath("post-1"), scheduled}
+
+		f.usecase.Run("query")
+
+		assert.InDelta(t, time.Hour, f.cache.ReceivedExpiresIn["posts:search-index"], float64(time.Minute))
+	})
+
+	t.Run("It ignores matches that are not published posts", func(t *testing.T) {
+		f := setup()
+		f.repo.ReturnPosts = []blog.Post{newPostWithPath("post-1")}
+		f.index.ReturnMatches = []blog.SearchMatch{{Path: "unknown"}}
+
+		results, err := f.usecase.Run("query")
+
+		assert.Nil(t, err)
+		assert.Equal(t, []blog.SearchResult{}, results)
+	})
+
+	t.Run("It returns no results for an empty query", func(t *testing.T) {
+		f := setup()
+
+		results, err := f.usecase.Run("  ")
+
+		assert.Nil(t, err)
+		assert.Equal(t, []blog.SearchResult{}, results)
+		assert.Equal(t, 0, f.index.IndexCalls)
+	})
+
+	t.Run("It returns the errors", func(t *testing.T) {
+		f := setup()
+		f.repo.ReturnError = errors.New("repo error")
+
+		results, err := f.usecase.Run("query")
+
+		assert.Equal(t, []blog.SearchResult{}, results)
+		assert.Equal(t, f.repo.ReturnError, err)
+
+		f = setup()
+		f.index.ReturnIndexErr = errors.New("index error")
+
+		_, err = f.usecase.Run("query")
+
+		assert.Equal(t, f.index.ReturnIndexErr, err)
+
+		f = setup()
+		f.index.ReturnError = errors.New("search error")
+
+		_, err = f.usecase.Run("query")
+
+		assert.Equal(t, f.index.ReturnError, err)
+	})
+}
@@ -1,6 +1,10 @@
 package blog
 
-import "github.com/geisonbiazus/blog/internal/core/shared"
+import (
+	"time"
+
+	"github.com/geisonbiazus/blog/internal/core/shared"
+)
 
 type ViewPostUseCase struct {
 	postRepo PostRepo
@@ -21,11 +25,25 @@ func NewViewPostUseCase(
 }
 
 func (u *ViewPostUseCase) Run(path string) (RenderedPost, error) {
-	result, err := u.cache.Do(path, func() (interface{}, error) {
+	post, err := u.renderedPost(path)
+
+	if err != nil {
+		return post, err
+	}
+
+	if !post.Post.IsPublished(time.Now()) {
+		return RenderedPost{}, ErrPostNotFound
+	}
+
+	return post, nil
+}
+
+// renderedPost returns the post regardless of its publication status. The
+// status is checked outside of the cache so scheduled posts go live on time.
+func (u *ViewPostUseCase) renderedPost(path string) (RenderedPost, error) {
+	return cachedValue(u.cache, postCacheKeyPrefix+path, func() (RenderedPost, error) {
 		return u.run(path)
 	}, shared.NeverExpire)
-
-	return result.(RenderedPost), err
 }
 
 func (u *ViewPostUseCase) run(path string) (RenderedPost, error) {
@@ -39,14 +57,11 @@ func (u *ViewPostUseCase) run(path string) (RenderedPost, error) {
 }
 
 func (u *ViewPostUseCase) renderPost(post Post) (RenderedPost, error) {
-	renderedContent, err := u.renderer.Render(post.Markdown)
+	renderedContent, headings, err := u.renderer.Render(post.Markdown)
 
 	if err != nil {
 		return RenderedPost{}, err
 	}
 
-	return RenderedPost{
-		Post: post,
-		HTML: renderedContent,
-	}, nil
+	return NewRenderedPost(post, renderedContent, headings), nil
 }
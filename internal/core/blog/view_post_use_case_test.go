@@ -7,6 +7,7 @@ import (
 
 	"github.com/geisonbiazus/blog/internal/adapters/cache/memory"
 	"github.com/geisonbiazus/blog/internal/core/blog"
+	"github.com/geisonbiazus/blog/internal/core/shared"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -48,18 +49,45 @@ func TestViewPostUseCase(t *testing.T) {
 		post := newPost()
 		f.repo.ReturnPost = post
 		f.renderer.ReturnRenderedContent = "Rendered content"
+		f.renderer.ReturnHeadings = []blog.Heading{{Level: 2, Text: "Heading", ID: "heading"}}
 
 		rennderedPost, err := f.usecase.Run("path")
 
 		assert.Equal(t, "path", f.repo.ReceivedPath)
 		assert.Equal(t, post.Markdown, f.renderer.ReceivedContent)
 		assert.Equal(t, rennderedPost, blog.RenderedPost{
-			Post: post,
-			HTML: "Rendered content",
+			Post:     post,
+			HTML:     "Rendered content",
+			Headings: f.renderer.ReturnHeadings,
+			Hash:     blog.ContentHash(post.Metadata.Hash, "Rendered content"),
 		})
 		assert.Nil(t, err)
 	})
 
+	t.Run("It returns ErrPostNotFound for drafts and scheduled posts", func(t *testing.T) {
+		f := setup()
+
+		draft := newPost()
+		draft.Status = blog.PostDraft
+		f.repo.ReturnPost = draft
+
+		renderedPost, err := f.usecase.Run("path")
+
+		assert.Equal(t, blog.RenderedPost{}, renderedPost)
+		assert.Equal(t, blog.ErrPostNotFound, err)
+
+		f = setup()
+
+		scheduled := newPost()
+		scheduled.Time = time.Now().Add(time.Hour)
+		f.repo.ReturnPost = scheduled
+
+		renderedPost, err = f.usecase.Run("path")
+
+		assert.Equal(t, blog.RenderedPost{}, renderedPost)
+		assert.Equal(t, blog.ErrPostNotFound, err)
+	})
+
 	t.Run("It returns error when post fails to render", func(t *testing.T) {
 		f := setup()
 
@@ -73,6 +101,70 @@ func TestViewPostUseCase(t *testing.T) {
 	})
 }
 
+func TestViewPostUseCaseCacheKeys(t *testing.T) {
+	t.Run("It doesn't read the cached listings for posts with the same path", func(t *testing.T) {
+		repo := NewPostRepoSpy()
+		repo.ReturnPosts = []blog.Post{newPost()}
+		cache := memory.NewCache()
+
+		_, err := blog.NewListPostsUseCase(repo, cache).Run(1, 0)
+		assert.Nil(t, err)
+
+		repo.ReturnError = blog.ErrPostNotFound
+
+		for _, path := range []string{"posts:published", "posts:all", "published-posts", "all-posts"} {
+			_, err := blog.NewViewPostUseCase(repo, NewRendererSpy(), cache).Run(path)
+
+			assert.Equal(t, blog.ErrPostNotFound, err)
+		}
+	})
+
+	t.Run("It returns error when the cached value has an unexpected type", func(t *testing.T) {
+		cache := memory.NewCache()
+		cache.Do("post:path", func() (interface{}, error) { return "unexpected", nil }, shared.NeverExpire)
+
+		_, err := blog.NewViewPostUseCase(NewPostRepoSpy(), NewRendererSpy(), cache).Run("path")
+
+		assert.Equal(t, blog.ErrUnexpectedCachedValue, err)
+	})
+
+	t.Run("It returns the resolve error when waiting for a resolution that panics", func(t *testing.T) {
+		cache := memory.NewCache()
+		release := make(chan struct{})
+		result := make(chan error)
+
+		go func() {
+			defer func() { recover() }()
+
+			cache.Do("post:path", func() (interface{}, error) {
+				<-release
+				panic("resolve failed")
+			}, shared.NeverExpire)
+		}()
+
+		waitForCacheStats(t, cache, 1, 0)
+
+		go func() {
+			_, err := blog.NewViewPostUseCase(NewPostRepoSpy(), NewRendererSpy(), cache).Run("path")
+			result <- err
+		}()
+
+		waitForCacheStats(t, cache, 1, 1)
+		close(release)
+
+		assert.Equal(t, memory.ErrResolvePanicked, <-result)
+	})
+}
+
+func waitForCacheStats(t *testing.T, cache *memory.Cache, misses, hits uint64) {
+	t.Helper()
+
+	assert.Eventually(t, func() bool {
+		stats := cache.Stats()
+		return stats.Misses == misses && stats.Hits == hits
+	}, time.Second, time.Millisecond)
+}
+
 func newPost() blog.Post {
 	postTime, _ := time.Parse(time.RFC3339, "2021-04-03T00:00:00+00:00")
 
@@ -0,0 +1,52 @@
+package blog
+
+import (
+	"sort"
+
+	"github.com/geisonbiazus/blog/internal/core/shared"
+)
+
+type ViewSeriesUseCase struct {
+	listPosts *ListPostsUseCase
+}
+
+func NewViewSeriesUseCase(postRepo PostRepo, cache shared.Cache) *ViewSeriesUseCase {
+	return &ViewSeriesUseCase{
+		listPosts: NewListPostsUseCase(postRepo, cache),
+	}
+}
+
+func (u *ViewSeriesUseCase) Run(slug string) (Series, error) {
+	posts, err := u.listPosts.publishedPosts()
+
+	if err != nil {
+		return Series{}, err
+	}
+
+	series := Series{Slug: slug, Posts: []Post{}}
+
+	for _, post := range posts {
+		if post.Series != "" && post.SeriesSlug() == slug {
+			series.Title = post.Series
+			series.Posts = append(series.Posts, post)
+		}
+	}
+
+	if len(series.Posts) == 0 {
+		return Series{}, ErrSeriesNotFound
+	}
+
+	u.sortSeriesPosts(series.Posts)
+
+	return series, nil
+}
+
+func (u *ViewSeriesUseCase) sortSeriesPosts(posts []Post) {
+	sort.SliceStable(posts, func(i, j int) bool {
+		if posts[i].SeriesOrder != posts[j].SeriesOrder {
+			return posts[i].SeriesOrder < posts[j].SeriesOrder
+		}
+
+		return posts[i].Time.Before(posts[j].Time)
+	})
+}
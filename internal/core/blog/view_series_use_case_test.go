@@ -0,0 +1,70 @@
+package blog_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/geisonbiazus/blog/internal/adapters/cache"
+	"github.com/geisonbiazus/blog/internal/core/blog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestViewSeriesUseCase(t *testing.T) {
+	setup := func() (*blog.ViewSeriesUseCase, *PostRepoSpy) {
+		repo := NewPostRepoSpy()
+		usecase := blog.NewViewSeriesUseCase(repo, cache.NewMemoryCache())
+		return usecase, repo
+	}
+
+	newSeriesPost := func(path string, order int, postTime time.Time) blog.Post {
+		post := newPost()
+		post.Path = path
+		post.Series = "Data Structures"
+		post.SeriesOrder = order
+		post.Time = postTime
+		return post
+	}
+
+	t.Run("It returns the posts of the series ordered by series order and time", func(t *testing.T) {
+		usecase, repo := setup()
+
+		base := time.Date(2022, time.May, 3, 9, 0, 0, 0, time.UTC)
+		part1 := newSeriesPost("part-1", 0, base)
+		part2 := newSeriesPost("part-2", 0, base.Add(time.Hour))
+		part3 := newSeriesPost("part-3", 2, base.Add(-time.Hour))
+		other := newPost()
+
+		repo.ReturnPosts = []blog.Post{part3, other, part2, part1}
+
+		series, err := usecase.Run("data-structures")
+
+		assert.Nil(t, err)
+		assert.Equal(t, blog.Series{
+			Slug:  "data-structures",
+			Title: "Data Structures",
+			Posts: []blog.Post{part1, part2, part3},
+		}, series)
+	})
+
+	t.Run("It returns ErrSeriesNotFound when no post belongs to the series", func(t *testing.T) {
+		usecase, repo := setup()
+
+		repo.ReturnPosts = []blog.Post{newPost()}
+
+		series, err := usecase.Run("unknown")
+
+		assert.Equal(t, blog.Series{}, series)
+		assert.Equal(t, blog.ErrSeriesNotFound, err)
+	})
+
+	t.Run("It returns the error from the repo", func(t *testing.T) {
+		usecase, repo := setup()
+
+		repo.ReturnError = errors.New("repo error")
+
+		_, err := usecase.Run("data-structures")
+
+		assert.Equal(t, repo.ReturnError, err)
+	})
+}
@@ -0,0 +1,158 @@
+package discussion
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/geisonbiazus/blog/internal/core/shared"
+)
+
+type CreateCommentInput struct {
+	UserID    string
+	SubjectID string
+	Markdown  string
+}
+
+type CreateCommentUseCase struct {
+	commentRepo    CommentRepo
+	subjectChecker SubjectChecker
+	renderer       Renderer
+	idGen          shared.IDGenerator
+}
+
+func NewCreateCommentUseCase(
+	commentRepo CommentRepo,
+	subjectChecker SubjectChecker,
+	renderer Renderer,
+	idGen shared.IDGenerator,
+) *CreateCommentUseCase {
+	return &CreateCommentUseCase{
+		commentRepo:    commentRepo,
+		subjectChecker: subjectChecker,
+		renderer:       renderer,
+		idGen:          idGen,
+	}
+}
+
+func (u *CreateCommentUseCase) Run(ctx context.Context, input CreateCommentInput) (*Comment, error) {
+	if strings.TrimSpace(input.Markdown) == "" {
+		return &Comment{}, ErrEmptyComment
+	}
+
+	author, err := u.findAuthor(ctx, input.UserID)
+	if err != nil {
+		return &Comment{}, err
+	}
+
+	err = u.checkSubject(ctx, input.SubjectID)
+	if err != nil {
+		return &Comment{}, err
+	}
+
+	comment, err := u.buildComment(author, input)
+	if err != nil {
+		return &Comment{}, err
+	}
+
+	comment.Status, err = u.initialStatus(ctx, author)
+	if err != nil {
+		return &Comment{}, err
+	}
+
+	err = u.commentRepo.SaveComment(ctx, comment)
+	if err != nil {
+		return &Comment{}, fmt.Errorf("error on CreateCommentUseCase.Run when saving comment: %w", err)
+	}
+
+	return comment, nil
+}
+
+func (u *CreateCommentUseCase) findAuthor(ctx context.Context, userID string) (*Author, error) {
+	author, err := u.commentRepo.GetAuthorByUserID(ctx, userID)
+	if err != nil {
+		return &Author{}, fmt.Errorf("error on CreateCommentUseCase.Run when finding author: %w", err)
+	}
+
+	if author == nil {
+		return &Author{}, ErrAuthorNotFound
+	}
+
+	if author.Banned {
+		return &Author{}, ErrAuthorBanned
+	}
+
+	return author, nil
+}
+
+// checkSubject makes sure the thread the comment goes to starts from a subject
+// open for comments. Replies have the comment they reply to as subject.
+func (u *CreateCommentUseCase) checkSubject(ctx context.Context, subjectID string) error {
+	rootSubjectID, err := u.resolveRootSubjectID(ctx, subjectID)
+	if err != nil {
+		return err
+	}
+
+	open, err := u.subjectChecker.IsOpenForComments(ctx, rootSubjectID)
+	if err != nil {
+		return fmt.Errorf("error on CreateCommentUseCase.Run when checking subject: %w", err)
+	}
+
+	if !open {
+		return ErrSubjectNotFound
+	}
+
+	return nil
+}
+
+func (u *CreateCommentUseCase) resolveRootSubjectID(ctx context.Context, subjectID string) (string, error) {
+	for {
+		parent, err := u.commentRepo.GetCommentByID(ctx, subjectID)
+		if err != nil {
+			return "", fmt.Errorf("error on CreateCommentUseCase.Run when finding parent comment: %w", err)
+		}
+
+		if parent == nil {
+			return subjectID, nil
+		}
+
+		subjectID = parent.SubjectID
+	}
+}
+
+// initialStatus holds comments from first-time authors for moderation. Once an
+// author has a comment approved, the following ones are published right away.
+func (u *CreateCommentUseCase) initialStatus(ctx context.Context, author *Author) (CommentStatus, error) {
+	approved, err := u.commentRepo.HasApprovedComments(ctx, author.ID)
+	if err != nil {
+		return "", fmt.Errorf("error on CreateCommentUseCase.Run when checking approved comments: %w", err)
+	}
+
+	if approved {
+		return CommentApproved, nil
+	}
+
+	return CommentPending, nil
+}
+
+func (u *CreateCommentUseCase) buildComment(author *Author, input CreateCommentInput) (*Comment, error) {
+	html, err := u.renderer.Render(input.Markdown)
+	if err != nil {
+		return &Comment{}, fmt.Errorf("error on CreateCommentUseCase.Run when rendering comment: %w", err)
+	}
+
+	now := time.Now()
+
+	return &Comment{
+		ID:        u.idGen.Generate(),
+		SubjectID: input.SubjectID,
+		AuthorID:  author.ID,
+		Author:    author,
+		Markdown:  input.Markdown,
+		HTML:      html,
+		CreatedAt: now,
+		UpdatedAt: now,
+		Replies:   []*Comment{},
+	}, nil
+}
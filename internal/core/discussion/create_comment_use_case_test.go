@@ -0,0 +1,165 @@
+package discussion_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/geisonbiazus/blog/internal/adapters/commentrepo/memory"
+	"github.com/geisonbiazus/blog/internal/adapters/idgenerator/fake"
+	"github.com/geisonbiazus/blog/internal/core/discussion"
+	. "github.com/geisonbiazus/blog/internal/core/discussion/test"
+	"github.com/stretchr/testify/suite"
+)
+
+type CreateCommentUseCaseSuite struct {
+	suite.Suite
+	usecase        *discussion.CreateCommentUseCase
+	repo           *memory.CommentRepo
+	subjectChecker *SubjectCheckerStub
+	renderer       *RendererSpy
+	idGen          *fake.IDGenerator
+	ctx            context.Context
+	author         *discussion.Author
+}
+
+func (s *CreateCommentUseCaseSuite) SetupSubTest() {
+	s.ctx = context.Background()
+	s.repo = memory.NewCommentRepo()
+	s.renderer = NewRendererSpy()
+	s.renderer.ReturnRenderedContent = "<p>Comment</p>"
+	s.idGen = fake.NewIDGenerator()
+	s.idGen.ReturnID = "COMMENT_ID"
+	s.subjectChecker = NewSubjectCheckerStub()
+	s.usecase = discussion.NewCreateCommentUseCase(s.repo, s.subjectChecker, s.renderer, s.idGen)
+	s.author = NewAuthor(discussion.Author{})
+}
+
+func (s *CreateCommentUseCaseSuite) TestRun() {
+	s.Run("It renders and saves the comment for the author of the given user", func() {
+		s.repo.SaveAuthor(s.ctx, s.author)
+
+		comment, err := s.usecase.Run(s.ctx, s.input())
+
+		s.Nil(err)
+		s.Equal("COMMENT_ID", comment.ID)
+		s.Equal("post-path", comment.SubjectID)
+		s.Equal(s.author.ID, comment.AuthorID)
+		s.Equal(s.author, comment.Author)
+		s.Equal("Comment", comment.Markdown)
+		s.Equal("<p>Comment</p>", comment.HTML)
+		s.Equal("Comment", s.renderer.ReceivedContent)
+		s.WithinDuration(time.Now(), comment.CreatedAt, time.Second)
+
+		comments, _ := s.repo.GetCommentsAndRepliesRecursively(s.ctx, "post-path", s.author.UserID)
+
+		s.Equal([]*discussion.Comment{comment}, comments)
+	})
+
+	s.Run("It saves replies using the parent comment ID as subject", func() {
+		s.repo.SaveAuthor(s.ctx, s.author)
+		parent := NewComment(discussion.Comment{ID: "PARENT_ID", SubjectID: "post-path", AuthorID: s.author.ID})
+		s.repo.SaveComment(s.ctx, parent)
+
+		input := s.input()
+		input.SubjectID = parent.ID
+
+		reply, err := s.usecase.Run(s.ctx, input)
+
+		s.Nil(err)
+
+		comments, _ := s.repo.GetCommentsAndRepliesRecursively(s.ctx, "post-path", s.author.UserID)
+
+		s.Equal(reply.ID, comments[0].Replies[0].ID)
+		s.Equal("post-path", s.subjectChecker.ReceivedSubjectID)
+	})
+
+	s.Run("It returns error when the subject is not open for comments", func() {
+		s.repo.SaveAuthor(s.ctx, s.author)
+		s.subjectChecker.ReturnOpen = false
+
+		_, err := s.usecase.Run(s.ctx, s.input())
+
+		comments, _ := s.repo.GetCommentsAndRepliesRecursively(s.ctx, "post-path", s.author.UserID)
+
+		s.Equal(discussion.ErrSubjectNotFound, err)
+		s.Equal("post-path", s.subjectChecker.ReceivedSubjectID)
+		s.Empty(comments)
+	})
+
+	s.Run("It returns error when checking the subject fails", func() {
+		s.repo.SaveAuthor(s.ctx, s.author)
+		s.subjectChecker.ReturnError = errors.New("check error")
+
+		_, err := s.usecase.Run(s.ctx, s.input())
+
+		s.ErrorIs(err, s.subjectChecker.ReturnError)
+	})
+
+	s.Run("It holds the comment for moderation when the author has no approved comments", func() {
+		s.repo.SaveAuthor(s.ctx, s.author)
+
+		comment, err := s.usecase.Run(s.ctx, s.input())
+
+		s.Nil(err)
+		s.Equal(discussion.CommentPending, comment.Status)
+	})
+
+	s.Run("It approves the comment when the author already has approved comments", func() {
+		s.repo.SaveAuthor(s.ctx, s.author)
+		s.repo.SaveComment(s.ctx, NewComment(discussion.Comment{ID: "APPROVED_ID", AuthorID: s.author.ID}))
+
+		comment, err := s.usecase.Run(s.ctx, s.input())
+
+		s.Nil(err)
+		s.Equal(discussion.CommentApproved, comment.Status)
+	})
+
+	s.Run("It returns error when the markdown is empty", func() {
+		s.repo.SaveAuthor(s.ctx, s.author)
+
+		input := s.input()
+		input.Markdown = " \n "
+
+		_, err := s.usecase.Run(s.ctx, input)
+
+		s.Equal(discussion.ErrEmptyComment, err)
+	})
+
+	s.Run("It returns error when the author doesn't exist", func() {
+		_, err := s.usecase.Run(s.ctx, s.input())
+
+		s.Equal(discussion.ErrAuthorNotFound, err)
+	})
+
+	s.Run("It returns error when the author is banned", func() {
+		s.author.Banned = true
+		s.repo.SaveAuthor(s.ctx, s.author)
+
+		_, err := s.usecase.Run(s.ctx, s.input())
+
+		s.Equal(discussion.ErrAuthorBanned, err)
+	})
+
+	s.Run("It returns error when rendering fails", func() {
+		s.repo.SaveAuthor(s.ctx, s.author)
+		s.renderer.ReturnError = errors.New("render error")
+
+		_, err := s.usecase.Run(s.ctx, s.input())
+
+		s.ErrorIs(err, s.renderer.ReturnError)
+	})
+}
+
+func (s *CreateCommentUseCaseSuite) input() discussion.CreateCommentInput {
+	return discussion.CreateCommentInput{
+		UserID:    s.author.UserID,
+		SubjectID: "post-path",
+		Markdown:  "Comment",
+	}
+}
+
+func TestCreateCommentUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(CreateCommentUseCaseSuite))
+}
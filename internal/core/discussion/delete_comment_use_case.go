@@ -0,0 +1,95 @@
+package discussion
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/geisonbiazus/blog/internal/core/shared"
+)
+
+type DeleteCommentUseCase struct {
+	commentRepo CommentRepo
+	txManager   shared.TransactionManager
+}
+
+func NewDeleteCommentUseCase(commentRepo CommentRepo, txManager shared.TransactionManager) *DeleteCommentUseCase {
+	return &DeleteCommentUseCase{
+		commentRepo: commentRepo,
+		txManager:   txManager,
+	}
+}
+
+func (u *DeleteCommentUseCase) Run(ctx context.Context, userID, commentID string) error {
+	return u.txManager.Transaction(ctx, func(ctx context.Context) error {
+		return u.run(ctx, userID, commentID)
+	})
+}
+
+func (u *DeleteCommentUseCase) run(ctx context.Context, userID, commentID string) error {
+	comment, err := findOwnComment(ctx, u.commentRepo, userID, commentID)
+	if err != nil {
+		return err
+	}
+
+	hasReplies, err := u.commentRepo.HasReplies(ctx, comment.ID)
+	if err != nil {
+		return fmt.Errorf("error on DeleteCommentUseCase.Run when checking replies: %w", err)
+	}
+
+	if hasReplies {
+		return u.softDelete(ctx, comment)
+	}
+
+	err = u.commentRepo.DeleteComment(ctx, comment.ID)
+	if err != nil {
+		return fmt.Errorf("error on DeleteCommentUseCase.Run when deleting comment: %w", err)
+	}
+
+	return u.deleteEmptyPlaceholders(ctx, comment.SubjectID)
+}
+
+// deleteEmptyPlaceholders removes the soft deleted comments up the thread once
+// the last of the replies they were kept for is gone.
+func (u *DeleteCommentUseCase) deleteEmptyPlaceholders(ctx context.Context, subjectID string) error {
+	for {
+		parent, err := u.commentRepo.GetCommentByID(ctx, subjectID)
+		if err != nil {
+			return fmt.Errorf("error on DeleteCommentUseCase.Run when finding parent comment: %w", err)
+		}
+
+		if parent == nil || !parent.IsDeleted() {
+			return nil
+		}
+
+		hasReplies, err := u.commentRepo.HasReplies(ctx, parent.ID)
+		if err != nil {
+			return fmt.Errorf("error on DeleteCommentUseCase.Run when checking parent replies: %w", err)
+		}
+
+		if hasReplies {
+			return nil
+		}
+
+		err = u.commentRepo.DeleteComment(ctx, parent.ID)
+		if err != nil {
+			return fmt.Errorf("error on DeleteCommentUseCase.Run when deleting parent comment: %w", err)
+		}
+
+		subjectID = parent.SubjectID
+	}
+}
+
+// softDelete keeps the comment row so its replies remain attached to the thread.
+func (u *DeleteCommentUseCase) softDelete(ctx context.Context, comment *Comment) error {
+	comment.Markdown = ""
+	comment.HTML = ""
+	comment.DeletedAt = time.Now()
+
+	err := u.commentRepo.UpdateComment(ctx, comment)
+	if err != nil {
+		return fmt.Errorf("error on DeleteCommentUseCase.Run when soft deleting comment: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package discussion_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/geisonbiazus/blog/internal/adapters/commentrepo/memory"
+	"github.com/geisonbiazus/blog/internal/adapters/transactionmanager"
+	"github.com/geisonbiazus/blog/internal/core/discussion"
+	. "github.com/geisonbiazus/blog/internal/core/discussion/test"
+	"github.com/stretchr/testify/suite"
+)
+
+type DeleteCommentUseCaseSuite struct {
+	suite.Suite
+	usecase *discussion.DeleteCommentUseCase
+	repo    *memory.CommentRepo
+	ctx     context.Context
+	author  *discussion.Author
+	comment *discussion.Comment
+}
+
+func (s *DeleteCommentUseCaseSuite) SetupSubTest() {
+	s.ctx = context.Background()
+	s.repo = memory.NewCommentRepo()
+	txManager := transactionmanager.NewFakeTransactionManager()
+	s.usecase = discussion.NewDeleteCommentUseCase(s.repo, txManager)
+
+	s.author = NewAuthor(discussion.Author{})
+	s.comment = NewComment(discussion.Comment{AuthorID: s.author.ID, SubjectID: "post-path"})
+
+	s.repo.SaveAuthor(s.ctx, s.author)
+	s.repo.SaveComment(s.ctx, s.comment.Clone())
+}
+
+func (s *DeleteCommentUseCaseSuite) TestRun() {
+	s.Run("It removes a comment without replies", func() {
+		err := s.usecase.Run(s.ctx, s.author.UserID, s.comment.ID)
+
+		comment, _ := s.repo.GetCommentByID(s.ctx, s.comment.ID)
+
+		s.Nil(err)
+		s.Nil(comment)
+	})
+
+	s.Run("It soft deletes a comment with replies keeping the thread", func() {
+		reply := NewComment(discussion.Comment{ID: "REPLY_ID", SubjectID: s.comment.ID, AuthorID: s.author.ID})
+		s.repo.SaveComment(s.ctx, reply)
+
+		err := s.usecase.Run(s.ctx, s.author.UserID, s.comment.ID)
+
+		s.Nil(err)
+
+		comments, _ := s.repo.GetCommentsAndRepliesRecursively(s.ctx, "post-path", "")
+
+		s.Len(comments, 1)
+		s.True(comments[0].IsDeleted())
+		s.Equal("", comments[0].Markdown)
+		s.Equal("", comments[0].HTML)
+		s.Equal("REPLY_ID", comments[0].Replies[0].ID)
+	})
+
+	s.Run("It removes the deleted parents left without replies", func() {
+		reply := NewComment(discussion.Comment{ID: "REPLY_ID", SubjectID: s.comment.ID, AuthorID: s.author.ID})
+		nestedReply := NewComment(discussion.Comment{ID: "NESTED_REPLY_ID", SubjectID: reply.ID, AuthorID: s.author.ID})
+		s.repo.SaveComment(s.ctx, reply)
+		s.repo.SaveComment(s.ctx, nestedReply)
+
+		s.usecase.Run(s.ctx, s.author.UserID, s.comment.ID)
+		s.usecase.Run(s.ctx, s.author.UserID, reply.ID)
+
+		err := s.usecase.Run(s.ctx, s.author.UserID, nestedReply.ID)
+
+		comments, _ := s.repo.GetCommentsAndRepliesRecursively(s.ctx, "post-path", "")
+
+		s.Nil(err)
+		s.Empty(comments)
+	})
+
+	s.Run("It keeps the deleted parents that still have replies", func() {
+		reply1 := NewComment(discussion.Comment{ID: "REPLY_1", SubjectID: s.comment.ID, AuthorID: s.author.ID})
+		reply2 := NewComment(discussion.Comment{ID: "REPLY_2", SubjectID: s.comment.ID, AuthorID: s.author.ID})
+		s.repo.SaveComment(s.ctx, reply1)
+		s.repo.SaveComment(s.ctx, reply2)
+
+		s.usecase.Run(s.ctx, s.author.UserID, s.comment.ID)
+		err := s.usecase.Run(s.ctx, s.author.UserID, reply1.ID)
+
+		comments, _ := s.repo.GetCommentsAndRepliesRecursively(s.ctx, "post-path", "")
+
+		s.Nil(err)
+		s.Len(comments, 1)
+		s.True(comments[0].IsDeleted())
+		s.Len(comments[0].Replies, 1)
+		s.Equal("REPLY_2", comments[0].Replies[0].ID)
+	})
+
+	s.Run("It returns error when the comment doesn't exist", func() {
+		err := s.usecase.Run(s.ctx, s.author.UserID, "UNKNOWN_ID")
+
+		s.Equal(discussion.ErrCommentNotFound, err)
+	})
+
+	s.Run("It returns error when the user doesn't own the comment", func() {
+		err := s.usecase.Run(s.ctx, "ANOTHER_USER_ID", s.comment.ID)
+
+		comment, _ := s.repo.GetCommentByID(s.ctx, s.comment.ID)
+
+		s.Equal(discussion.ErrNotCommentOwner, err)
+		s.NotNil(comment)
+	})
+
+	s.Run("It returns error when the author is banned", func() {
+		s.author.Banned = true
+		s.repo.SaveAuthor(s.ctx, s.author)
+
+		err := s.usecase.Run(s.ctx, s.author.UserID, s.comment.ID)
+
+		comment, _ := s.repo.GetCommentByID(s.ctx, s.comment.ID)
+
+		s.Equal(discussion.ErrAuthorBanned, err)
+		s.NotNil(comment)
+	})
+}
+
+func TestDeleteCommentUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(DeleteCommentUseCaseSuite))
+}
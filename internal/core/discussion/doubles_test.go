@@ -0,0 +1,66 @@
+package discussion_test
+
+import "context"
+
+type RendererSpy struct {
+	ReceivedContent       string
+	ReturnRenderedContent string
+	ReturnError           error
+}
+
+func NewRendererSpy() *RendererSpy {
+	return &RendererSpy{}
+}
+
+func (r *RendererSpy) Render(content string) (string, error) {
+	r.ReceivedContent = content
+	return r.ReturnRenderedContent, r.ReturnError
+}
+
+type SubjectCheckerStub struct {
+	ReceivedSubjectID string
+	ReturnOpen        bool
+	ReturnError       error
+}
+
+func NewSubjectCheckerStub() *SubjectCheckerStub {
+	return &SubjectCheckerStub{ReturnOpen: true}
+}
+
+func (c *SubjectCheckerStub) IsOpenForComments(ctx context.Context, subjectID string) (bool, error) {
+	c.ReceivedSubjectID = subjectID
+	return c.ReturnOpen, c.ReturnError
+}
+
+type AuthorizerStub struct {
+	ReceivedUserID    string
+	ReturnCanModerate bool
+	ReturnError       error
+}
+
+func NewAuthorizerStub() *AuthorizerStub {
+	return &AuthorizerStub{ReturnCanModerate: true}
+}
+
+func (a *AuthorizerStub) CanModerateComments(ctx context.Context, userID string) (bool, error) {
+	a.ReceivedUserID = userID
+	return a.ReturnCanModerate, a.ReturnError
+}
+
+// TransactionManagerStub runs the callback and then fails with ReturnError, as
+// when the commit fails.
+type TransactionManagerStub struct {
+	ReturnError error
+}
+
+func NewTransactionManagerStub() *TransactionManagerStub {
+	return &TransactionManagerStub{}
+}
+
+func (t *TransactionManagerStub) Transaction(ctx context.Context, callback func(ctx context.Context) error) error {
+	if err := callback(ctx); err != nil {
+		return err
+	}
+
+	return t.ReturnError
+}
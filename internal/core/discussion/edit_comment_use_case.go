@@ -0,0 +1,85 @@
+package discussion
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/geisonbiazus/blog/internal/core/shared"
+)
+
+type EditCommentInput struct {
+	UserID    string
+	CommentID string
+	Markdown  string
+}
+
+type EditCommentUseCase struct {
+	commentRepo CommentRepo
+	renderer    Renderer
+	txManager   shared.TransactionManager
+	idGen       shared.IDGenerator
+}
+
+func NewEditCommentUseCase(
+	commentRepo CommentRepo,
+	renderer Renderer,
+	txManager shared.TransactionManager,
+	idGen shared.IDGenerator,
+) *EditCommentUseCase {
+	return &EditCommentUseCase{
+		commentRepo: commentRepo,
+		renderer:    renderer,
+		txManager:   txManager,
+		idGen:       idGen,
+	}
+}
+
+func (u *EditCommentUseCase) Run(ctx context.Context, input EditCommentInput) (comment *Comment, err error) {
+	if strings.TrimSpace(input.Markdown) == "" {
+		return &Comment{}, ErrEmptyComment
+	}
+
+	err = u.txManager.Transaction(ctx, func(ctx context.Context) error {
+		comment, err = u.run(ctx, input)
+		return err
+	})
+	return
+}
+
+func (u *EditCommentUseCase) run(ctx context.Context, input EditCommentInput) (*Comment, error) {
+	comment, err := findOwnComment(ctx, u.commentRepo, input.UserID, input.CommentID)
+	if err != nil {
+		return &Comment{}, err
+	}
+
+	html, err := u.renderer.Render(input.Markdown)
+	if err != nil {
+		return &Comment{}, fmt.Errorf("error on EditCommentUseCase.Run when rendering comment: %w", err)
+	}
+
+	now := time.Now()
+
+	err = u.commentRepo.SaveCommentRevision(ctx, &CommentRevision{
+		ID:        u.idGen.Generate(),
+		CommentID: comment.ID,
+		Markdown:  comment.Markdown,
+		HTML:      comment.HTML,
+		CreatedAt: now,
+	})
+	if err != nil {
+		return &Comment{}, fmt.Errorf("error on EditCommentUseCase.Run when saving revision: %w", err)
+	}
+
+	comment.Markdown = input.Markdown
+	comment.HTML = html
+	comment.UpdatedAt = now
+
+	err = u.commentRepo.UpdateComment(ctx, comment)
+	if err != nil {
+		return &Comment{}, fmt.Errorf("error on EditCommentUseCase.Run when updating comment: %w", err)
+	}
+
+	return comment, nil
+}
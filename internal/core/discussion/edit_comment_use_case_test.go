@@ -0,0 +1,155 @@
+package discussion_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/geisonbiazus/blog/internal/adapters/commentrepo/memory"
+	"github.com/geisonbiazus/blog/internal/adapters/idgenerator/fake"
+	"github.com/geisonbiazus/blog/internal/core/discussion"
+	. "github.com/geisonbiazus/blog/internal/core/discussion/test"
+	"github.com/stretchr/testify/suite"
+)
+
+type EditCommentUseCaseSuite struct {
+	suite.Suite
+	usecase  *discussion.EditCommentUseCase
+	repo     *memory.CommentRepo
+	renderer *RendererSpy
+	idGen    *fake.IDGenerator
+	tx       *TransactionManagerStub
+	ctx      context.Context
+	author   *discussion.Author
+	comment  *discussion.Comment
+}
+
+func (s *EditCommentUseCaseSuite) SetupSubTest() {
+	s.ctx = context.Background()
+	s.repo = memory.NewCommentRepo()
+	s.renderer = NewRendererSpy()
+	s.renderer.ReturnRenderedContent = "<p>Edited</p>"
+	s.idGen = fake.NewIDGenerator()
+	s.idGen.ReturnID = "REVISION_ID"
+	s.tx = NewTransactionManagerStub()
+	s.usecase = discussion.NewEditCommentUseCase(s.repo, s.renderer, s.tx, s.idGen)
+
+	s.author = NewAuthor(discussion.Author{})
+	s.comment = NewComment(discussion.Comment{
+		AuthorID:  s.author.ID,
+		Markdown:  "Original",
+		HTML:      "<p>Original</p>",
+		CreatedAt: time.Now().Add(-time.Hour),
+	})
+
+	s.repo.SaveAuthor(s.ctx, s.author)
+	s.repo.SaveComment(s.ctx, s.comment.Clone())
+}
+
+func (s *EditCommentUseCaseSuite) TestRun() {
+	s.Run("It updates the comment and marks it as edited", func() {
+		comment, err := s.usecase.Run(s.ctx, s.input())
+
+		s.Nil(err)
+		s.Equal("Edited", comment.Markdown)
+		s.Equal("<p>Edited</p>", comment.HTML)
+		s.Equal("Edited", s.renderer.ReceivedContent)
+		s.WithinDuration(time.Now(), comment.UpdatedAt, time.Second)
+		s.True(comment.IsEdited())
+
+		persisted, _ := s.repo.GetCommentByID(s.ctx, s.comment.ID)
+
+		s.Equal("Edited", persisted.Markdown)
+		s.Equal("<p>Edited</p>", persisted.HTML)
+	})
+
+	s.Run("It keeps the previous content as a revision", func() {
+		s.usecase.Run(s.ctx, s.input())
+
+		revisions, _ := s.repo.GetCommentRevisions(s.ctx, s.comment.ID)
+
+		s.Len(revisions, 1)
+		s.Equal("REVISION_ID", revisions[0].ID)
+		s.Equal("Original", revisions[0].Markdown)
+		s.Equal("<p>Original</p>", revisions[0].HTML)
+	})
+
+	s.Run("It returns error when the markdown is empty", func() {
+		input := s.input()
+		input.Markdown = " "
+
+		_, err := s.usecase.Run(s.ctx, input)
+
+		s.Equal(discussion.ErrEmptyComment, err)
+	})
+
+	s.Run("It returns error when the comment doesn't exist", func() {
+		input := s.input()
+		input.CommentID = "UNKNOWN_ID"
+
+		_, err := s.usecase.Run(s.ctx, input)
+
+		s.Equal(discussion.ErrCommentNotFound, err)
+	})
+
+	s.Run("It returns error when the comment was deleted", func() {
+		deleted := s.comment.Clone()
+		deleted.DeletedAt = time.Now()
+		s.repo.UpdateComment(s.ctx, deleted)
+
+		_, err := s.usecase.Run(s.ctx, s.input())
+
+		s.Equal(discussion.ErrCommentNotFound, err)
+	})
+
+	s.Run("It returns error when the user doesn't own the comment", func() {
+		input := s.input()
+		input.UserID = "ANOTHER_USER_ID"
+
+		_, err := s.usecase.Run(s.ctx, input)
+		revisions, _ := s.repo.GetCommentRevisions(s.ctx, s.comment.ID)
+
+		s.Equal(discussion.ErrNotCommentOwner, err)
+		s.Empty(revisions)
+	})
+
+	s.Run("It returns error when the author is banned", func() {
+		s.author.Banned = true
+		s.repo.SaveAuthor(s.ctx, s.author)
+
+		_, err := s.usecase.Run(s.ctx, s.input())
+		comment, _ := s.repo.GetCommentByID(s.ctx, s.comment.ID)
+
+		s.Equal(discussion.ErrAuthorBanned, err)
+		s.Equal("Original", comment.Markdown)
+	})
+
+	s.Run("It returns error when the comment can't be rendered", func() {
+		s.renderer.ReturnError = errors.New("render error")
+
+		_, err := s.usecase.Run(s.ctx, s.input())
+
+		s.ErrorIs(err, s.renderer.ReturnError)
+	})
+
+	s.Run("It returns error when the transaction fails", func() {
+		s.tx.ReturnError = errors.New("commit error")
+
+		_, err := s.usecase.Run(s.ctx, s.input())
+
+		s.Equal(s.tx.ReturnError, err)
+	})
+}
+
+func (s *EditCommentUseCaseSuite) input() discussion.EditCommentInput {
+	return discussion.EditCommentInput{
+		UserID:    s.author.UserID,
+		CommentID: s.comment.ID,
+		Markdown:  "Edited",
+	}
+}
+
+func TestEditCommentUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(EditCommentUseCaseSuite))
+}
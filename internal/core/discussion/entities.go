@@ -1,6 +1,7 @@
 package discussion
 
 import (
+	"errors"
 	"time"
 )
 
@@ -11,8 +12,19 @@ type Comment struct {
 	Author    *Author
 	Markdown  string
 	HTML      string
+	Status    CommentStatus
 	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt time.Time
 	Replies   []*Comment
+
+	// RootSubjectID is the subject the thread of the comment starts from, e.g.
+	// the post of a reply. It's only resolved when listing recent comments.
+	RootSubjectID string
+
+	// Hidden marks a comment the viewer can't see that is kept in the thread,
+	// without its content and author, because some of its replies are visible.
+	Hidden bool
 }
 
 func (c *Comment) Clone() *Comment {
@@ -20,6 +32,67 @@ func (c *Comment) Clone() *Comment {
 	return &clone
 }
 
+func (c *Comment) IsEdited() bool {
+	return c.UpdatedAt.After(c.CreatedAt)
+}
+
+func (c *Comment) IsDeleted() bool {
+	return !c.DeletedAt.IsZero()
+}
+
+func (c *Comment) IsPending() bool {
+	return c.Status == CommentPending
+}
+
+func (c *Comment) hide() *Comment {
+	return &Comment{
+		ID:        c.ID,
+		SubjectID: c.SubjectID,
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.CreatedAt,
+		Replies:   c.Replies,
+		Hidden:    true,
+	}
+}
+
+// HideComments goes through the whole thread keeping the comments visible to
+// the viewer. Hidden and deleted comments are kept as placeholders while they
+// have visible replies, so the replies stay in place, and left out otherwise.
+func HideComments(comments []*Comment, isVisible func(*Comment) bool) []*Comment {
+	result := []*Comment{}
+
+	for _, comment := range comments {
+		comment.Replies = HideComments(comment.Replies, isVisible)
+		hasReplies := len(comment.Replies) > 0
+
+		switch {
+		case !isVisible(comment) && hasReplies:
+			result = append(result, comment.hide())
+		case isVisible(comment) && (hasReplies || !comment.IsDeleted()):
+			result = append(result, comment)
+		}
+	}
+
+	return result
+}
+
+type CommentStatus string
+
+const (
+	CommentPending  CommentStatus = "pending"
+	CommentApproved CommentStatus = "approved"
+	CommentRejected CommentStatus = "rejected"
+	CommentSpam     CommentStatus = "spam"
+)
+
+type CommentRevision struct {
+	ID        string
+	CommentID string
+	Markdown  string
+	HTML      string
+	CreatedAt time.Time
+}
+
 type Author struct {
 	Persisted bool
 
@@ -27,9 +100,19 @@ type Author struct {
 	UserID    string
 	Name      string
 	AvatarURL string
+	Banned    bool
 }
 
 func (a *Author) Clone() *Author {
 	clone := *a
 	return &clone
 }
+
+var ErrAuthorNotFound = errors.New("author not found")
+var ErrEmptyComment = errors.New("comment can't be empty")
+var ErrCommentNotFound = errors.New("comment not found")
+var ErrNotCommentOwner = errors.New("comment doesn't belong to the user")
+var ErrInvalidCommentStatus = errors.New("invalid comment status")
+var ErrAuthorBanned = errors.New("author is banned")
+var ErrSubjectNotFound = errors.New("subject not found")
+var ErrNotModerator = errors.New("user can't moderate comments")
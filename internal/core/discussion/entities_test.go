@@ -2,6 +2,7 @@ package discussion_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/geisonbiazus/blog/internal/core/discussion"
 	. "github.com/geisonbiazus/blog/internal/core/discussion/test"
@@ -17,4 +18,31 @@ func TestComment(t *testing.T) {
 			assert.False(t, comment == clone)
 		})
 	})
+
+	t.Run("IsEdited", func(t *testing.T) {
+		t.Run("It is false when the comment was never updated", func(t *testing.T) {
+			comment := NewComment(discussion.Comment{})
+
+			assert.False(t, comment.IsEdited())
+		})
+
+		t.Run("It is true when the comment was updated after creation", func(t *testing.T) {
+			comment := NewComment(discussion.Comment{})
+			comment.UpdatedAt = comment.CreatedAt.Add(time.Minute)
+
+			assert.True(t, comment.IsEdited())
+		})
+	})
+
+	t.Run("IsDeleted", func(t *testing.T) {
+		t.Run("It is true only when the comment has a deletion time", func(t *testing.T) {
+			comment := NewComment(discussion.Comment{})
+
+			assert.False(t, comment.IsDeleted())
+
+			comment.DeletedAt = time.Now()
+
+			assert.True(t, comment.IsDeleted())
+		})
+	})
 }
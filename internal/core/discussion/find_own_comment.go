@@ -0,0 +1,36 @@
+package discussion
+
+import (
+	"context"
+	"fmt"
+)
+
+// findOwnComment returns the comment when it belongs to the user, who must not
+// be banned to change it.
+func findOwnComment(ctx context.Context, commentRepo CommentRepo, userID, commentID string) (*Comment, error) {
+	comment, err := commentRepo.GetCommentByID(ctx, commentID)
+	if err != nil {
+		return &Comment{}, fmt.Errorf("error on findOwnComment when finding comment: %w", err)
+	}
+
+	if comment == nil || comment.IsDeleted() {
+		return &Comment{}, ErrCommentNotFound
+	}
+
+	author, err := commentRepo.GetAuthorByID(ctx, comment.AuthorID)
+	if err != nil {
+		return &Comment{}, fmt.Errorf("error on findOwnComment when finding author: %w", err)
+	}
+
+	if author == nil || author.UserID != userID {
+		return &Comment{}, ErrNotCommentOwner
+	}
+
+	if author.Banned {
+		return &Comment{}, ErrAuthorBanned
+	}
+
+	comment.Author = author
+
+	return comment, nil
+}
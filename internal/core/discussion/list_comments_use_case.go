@@ -10,6 +10,8 @@ func NewListCommentsUseCase(commentRepo CommentRepo) *ListCommentsUseCase {
 	return &ListCommentsUseCase{commentRepo}
 }
 
-func (u *ListCommentsUseCase) Run(ctx context.Context, subjectID string) ([]*Comment, error) {
-	return u.commentRepo.GetCommentsAndRepliesRecursively(ctx, subjectID)
+// Run returns the approved comments of the subject along with the pending ones
+// written by the viewer. viewerUserID is empty for anonymous visitors.
+func (u *ListCommentsUseCase) Run(ctx context.Context, subjectID, viewerUserID string) ([]*Comment, error) {
+	return u.commentRepo.GetCommentsAndRepliesRecursively(ctx, subjectID, viewerUserID)
 }
@@ -25,6 +25,7 @@ func TestListCommentsUseCase(t *testing.T) {
 		usecase := discussion.NewListCommentsUseCase(repo)
 		author := &discussion.Author{
 			ID:        "AUTHOR_ID",
+			UserID:    "USER_ID",
 			Name:      "Author",
 			AvatarURL: "https://example.com/avatar",
 		}
@@ -43,7 +44,7 @@ func TestListCommentsUseCase(t *testing.T) {
 		f := setup()
 		subjectID := "SUBJECT_ID"
 
-		result, err := f.usecase.Run(f.ctx, subjectID)
+		result, err := f.usecase.Run(f.ctx, subjectID, "")
 
 		assert.Equal(t, []*discussion.Comment{}, result)
 		assert.Nil(t, err)
@@ -69,7 +70,7 @@ func TestListCommentsUseCase(t *testing.T) {
 		f.repo.SaveComment(f.ctx, comment1)
 		f.repo.SaveComment(f.ctx, comment2)
 
-		result, err := f.usecase.Run(f.ctx, comment1.SubjectID)
+		result, err := f.usecase.Run(f.ctx, comment1.SubjectID, "")
 
 		assert.Equal(t, []*discussion.Comment{comment2, comment1}, result)
 		assert.Nil(t, err)
@@ -99,7 +100,7 @@ func TestListCommentsUseCase(t *testing.T) {
 		f.repo.SaveComment(f.ctx, reply1)
 		f.repo.SaveComment(f.ctx, reply2)
 
-		result, err := f.usecase.Run(f.ctx, comment.SubjectID)
+		result, err := f.usecase.Run(f.ctx, comment.SubjectID, "")
 
 		// TODO: Return author
 
@@ -134,4 +135,129 @@ func TestListCommentsUseCase(t *testing.T) {
 		assert.Equal(t, commentWithReplies[0].Replies[0].Replies[0], result[0].Replies[0].Replies[0])
 		assert.Nil(t, err)
 	})
+
+	t.Run("It only returns pending comments to their author", func(t *testing.T) {
+		f := setup()
+
+		approved := NewComment(discussion.Comment{ID: "APPROVED", AuthorID: f.author.ID, Author: f.author})
+		pending := NewComment(discussion.Comment{
+			ID:        "PENDING",
+			AuthorID:  f.author.ID,
+			Author:    f.author,
+			Status:    discussion.CommentPending,
+			CreatedAt: approved.CreatedAt.Add(time.Hour),
+		})
+
+		f.repo.SaveComment(f.ctx, approved)
+		f.repo.SaveComment(f.ctx, pending)
+
+		result, err := f.usecase.Run(f.ctx, approved.SubjectID, "OTHER_USER_ID")
+
+		assert.Nil(t, err)
+		assert.Equal(t, []*discussion.Comment{approved}, result)
+
+		result, err = f.usecase.Run(f.ctx, approved.SubjectID, f.author.UserID)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []*discussion.Comment{approved, pending}, result)
+	})
+	t.Run("It hides rejected and spam comments from their author too", func(t *testing.T) {
+		for _, status := range []discussion.CommentStatus{discussion.CommentRejected, discussion.CommentSpam} {
+			f := setup()
+
+			approved := NewComment(discussion.Comment{ID: "APPROVED", AuthorID: f.author.ID, Author: f.author})
+			hidden := NewComment(discussion.Comment{
+				ID:        "HIDDEN",
+				AuthorID:  f.author.ID,
+				Author:    f.author,
+				Status:    status,
+				CreatedAt: approved.CreatedAt.Add(time.Hour),
+			})
+
+			f.repo.SaveComment(f.ctx, approved)
+			f.repo.SaveComment(f.ctx, hidden)
+
+			result, err := f.usecase.Run(f.ctx, approved.SubjectID, f.author.UserID)
+
+			assert.Nil(t, err)
+			assert.Equal(t, []*discussion.Comment{approved}, result, status)
+		}
+	})
+
+	t.Run("It keeps hidden comments as placeholders for their visible replies", func(t *testing.T) {
+		f := setup()
+		otherAuthor := NewAuthor(discussion.Author{ID: "OTHER_AUTHOR_ID", UserID: "OTHER_USER_ID"})
+		bannedAuthor := NewAuthor(discussion.Author{ID: "BANNED_AUTHOR_ID", UserID: "BANNED_USER_ID"})
+		bannedAuthor.Banned = true
+		f.repo.SaveAuthor(f.ctx, otherAuthor)
+		f.repo.SaveAuthor(f.ctx, bannedAuthor)
+
+		rejected := NewComment(discussion.Comment{ID: "REJECTED", AuthorID: f.author.ID, Status: discussion.CommentRejected})
+		banned := NewComment(discussion.Comment{
+			ID:        "BANNED",
+			SubjectID: rejected.ID,
+			AuthorID:  bannedAuthor.ID,
+			CreatedAt: rejected.CreatedAt.Add(time.Hour),
+		})
+		reply := NewComment(discussion.Comment{
+			ID:        "REPLY",
+			SubjectID: banned.ID,
+			AuthorID:  otherAuthor.ID,
+			Author:    otherAuthor,
+			CreatedAt: rejected.CreatedAt.Add(2 * time.Hour),
+		})
+
+		f.repo.SaveComment(f.ctx, rejected)
+		f.repo.SaveComment(f.ctx, banned)
+		f.repo.SaveComment(f.ctx, reply)
+
+		result, err := f.usecase.Run(f.ctx, rejected.SubjectID, f.author.UserID)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []*discussion.Comment{
+			{
+				ID:        rejected.ID,
+				SubjectID: rejected.SubjectID,
+				CreatedAt: rejected.CreatedAt,
+				UpdatedAt: rejected.CreatedAt,
+				Hidden:    true,
+				Replies: []*discussion.Comment{
+					{
+						ID:        banned.ID,
+						SubjectID: banned.SubjectID,
+						CreatedAt: banned.CreatedAt,
+						UpdatedAt: banned.CreatedAt,
+						Hidden:    true,
+						Replies:   []*discussion.Comment{reply},
+					},
+				},
+			},
+		}, result)
+	})
+
+	t.Run("It leaves out hidden and deleted comments without visible replies", func(t *testing.T) {
+		f := setup()
+
+		rejected := NewComment(discussion.Comment{ID: "REJECTED", AuthorID: f.author.ID, Status: discussion.CommentRejected})
+		deleted := NewComment(discussion.Comment{
+			ID:        "DELETED",
+			AuthorID:  f.author.ID,
+			DeletedAt: rejected.CreatedAt,
+		})
+		pendingReply := NewComment(discussion.Comment{
+			ID:        "PENDING_REPLY",
+			SubjectID: deleted.ID,
+			AuthorID:  f.author.ID,
+			Status:    discussion.CommentPending,
+		})
+
+		f.repo.SaveComment(f.ctx, rejected)
+		f.repo.SaveComment(f.ctx, deleted)
+		f.repo.SaveComment(f.ctx, pendingReply)
+
+		result, err := f.usecase.Run(f.ctx, rejected.SubjectID, "OTHER_USER_ID")
+
+		assert.Nil(t, err)
+		assert.Empty(t, result)
+	})
 }
@@ -0,0 +1,31 @@
+package discussion
+
+import (
+	"context"
+	"fmt"
+)
+
+type ListPendingCommentsUseCase struct {
+	commentRepo CommentRepo
+	authorizer  Authorizer
+}
+
+func NewListPendingCommentsUseCase(commentRepo CommentRepo, authorizer Authorizer) *ListPendingCommentsUseCase {
+	return &ListPendingCommentsUseCase{
+		commentRepo: commentRepo,
+		authorizer:  authorizer,
+	}
+}
+
+func (u *ListPendingCommentsUseCase) Run(ctx context.Context, moderatorUserID string) ([]*Comment, error) {
+	canModerate, err := u.authorizer.CanModerateComments(ctx, moderatorUserID)
+	if err != nil {
+		return []*Comment{}, fmt.Errorf("error on ListPendingCommentsUseCase.Run when authorizing moderator: %w", err)
+	}
+
+	if !canModerate {
+		return []*Comment{}, ErrNotModerator
+	}
+
+	return u.commentRepo.GetCommentsByStatus(ctx, CommentPending)
+}
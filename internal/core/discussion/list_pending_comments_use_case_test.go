@@ -0,0 +1,77 @@
+package discussion_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/geisonbiazus/blog/internal/adapters/commentrepo/memory"
+	"github.com/geisonbiazus/blog/internal/core/discussion"
+	. "github.com/geisonbiazus/blog/internal/core/discussion/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListPendingCommentsUseCase(t *testing.T) {
+	type fixture struct {
+		ctx        context.Context
+		repo       *memory.CommentRepo
+		authorizer *AuthorizerStub
+		usecase    *discussion.ListPendingCommentsUseCase
+	}
+
+	setup := func() fixture {
+		repo := memory.NewCommentRepo()
+		authorizer := NewAuthorizerStub()
+
+		return fixture{
+			ctx:        context.Background(),
+			repo:       repo,
+			authorizer: authorizer,
+			usecase:    discussion.NewListPendingCommentsUseCase(repo, authorizer),
+		}
+	}
+
+	t.Run("It returns the pending comments with their authors in chronological order", func(t *testing.T) {
+		f := setup()
+		author := NewAuthor(discussion.Author{})
+		f.repo.SaveAuthor(f.ctx, author)
+
+		createdAt := time.Date(2022, time.October, 4, 9, 0, 0, 0, time.UTC)
+		pending1 := NewComment(discussion.Comment{ID: "PENDING_1", Author: author, Status: discussion.CommentPending, CreatedAt: createdAt.Add(time.Hour)})
+		pending2 := NewComment(discussion.Comment{ID: "PENDING_2", Author: author, Status: discussion.CommentPending, CreatedAt: createdAt})
+		approved := NewComment(discussion.Comment{ID: "APPROVED", Author: author})
+
+		f.repo.SaveComment(f.ctx, pending1)
+		f.repo.SaveComment(f.ctx, pending2)
+		f.repo.SaveComment(f.ctx, approved)
+
+		result, err := f.usecase.Run(f.ctx, "MODERATOR_USER_ID")
+
+		assert.Nil(t, err)
+		assert.Equal(t, []*discussion.Comment{pending2, pending1}, result)
+		assert.Equal(t, "MODERATOR_USER_ID", f.authorizer.ReceivedUserID)
+	})
+
+	t.Run("It returns error when the user can't moderate comments", func(t *testing.T) {
+		f := setup()
+		f.authorizer.ReturnCanModerate = false
+		author := NewAuthor(discussion.Author{})
+		f.repo.SaveAuthor(f.ctx, author)
+		f.repo.SaveComment(f.ctx, NewComment(discussion.Comment{Author: author, Status: discussion.CommentPending}))
+
+		result, err := f.usecase.Run(f.ctx, "USER_ID")
+
+		assert.Equal(t, discussion.ErrNotModerator, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("It returns error when the authorization fails", func(t *testing.T) {
+		f := setup()
+		f.authorizer.ReturnError = errors.New("authorizer error")
+
+		_, err := f.usecase.Run(f.ctx, "USER_ID")
+
+		assert.ErrorIs(t, err, f.authorizer.ReturnError)
+	})
+}
@@ -0,0 +1,17 @@
+package discussion
+
+import "context"
+
+type ListRecentCommentsUseCase struct {
+	commentRepo CommentRepo
+}
+
+func NewListRecentCommentsUseCase(commentRepo CommentRepo) *ListRecentCommentsUseCase {
+	return &ListRecentCommentsUseCase{commentRepo}
+}
+
+// Run returns up to limit of the latest visible comments of the subject, replies
+// included, newest first. Given an empty subjectID it looks into every subject.
+func (u *ListRecentCommentsUseCase) Run(ctx context.Context, subjectID string, limit int) ([]*Comment, error) {
+	return u.commentRepo.GetRecentComments(ctx, subjectID, limit)
+}
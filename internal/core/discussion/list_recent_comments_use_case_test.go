@@ -0,0 +1,131 @@
+package discussion_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/geisonbiazus/blog/internal/adapters/commentrepo/memory"
+	"github.com/geisonbiazus/blog/internal/core/discussion"
+	. "github.com/geisonbiazus/blog/internal/core/discussion/test"
+	"github.com/stretchr/testify/assert"
+)
+
+type listRecentCommentsUseCaseFixture struct {
+	ctx       context.Context
+	repo      *memory.CommentRepo
+	usecase   *discussion.ListRecentCommentsUseCase
+	author    *discussion.Author
+	createdAt time.Time
+}
+
+func TestListRecentCommentsUseCase(t *testing.T) {
+	setup := func() *listRecentCommentsUseCaseFixture {
+		ctx := context.Background()
+		repo := memory.NewCommentRepo()
+		author := NewAuthor(discussion.Author{})
+		repo.SaveAuthor(ctx, author)
+
+		return &listRecentCommentsUseCaseFixture{
+			ctx:       ctx,
+			repo:      repo,
+			usecase:   discussion.NewListRecentCommentsUseCase(repo),
+			author:    author,
+			createdAt: time.Date(2022, time.October, 4, 9, 0, 0, 0, time.UTC),
+		}
+	}
+
+	newComment := func(f *listRecentCommentsUseCaseFixture, id, subjectID, rootSubjectID string, hours int) *discussion.Comment {
+		comment := NewComment(discussion.Comment{
+			ID:        id,
+			SubjectID: subjectID,
+			Author:    f.author,
+			CreatedAt: f.createdAt.Add(time.Duration(hours) * time.Hour),
+		})
+		f.repo.SaveComment(f.ctx, comment)
+
+		expected := NewComment(*comment)
+		expected.RootSubjectID = rootSubjectID
+		return expected
+	}
+
+	t.Run("It returns the comments and replies of every subject, newest first", func(t *testing.T) {
+		f := setup()
+
+		comment1 := newComment(f, "COMMENT_1", "post-1", "post-1", 0)
+		comment2 := newComment(f, "COMMENT_2", "post-2", "post-2", 1)
+		reply := newComment(f, "REPLY", "COMMENT_1", "post-1", 2)
+
+		result, err := f.usecase.Run(f.ctx, "", 10)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []*discussion.Comment{reply, comment2, comment1}, result)
+	})
+
+	t.Run("Given a subject it returns only the comments of its threads", func(t *testing.T) {
+		f := setup()
+
+		comment1 := newComment(f, "COMMENT_1", "post-1", "post-1", 0)
+		newComment(f, "COMMENT_2", "post-2", "post-2", 1)
+		reply := newComment(f, "REPLY", "COMMENT_1", "post-1", 2)
+		nestedReply := newComment(f, "NESTED_REPLY", "REPLY", "post-1", 3)
+
+		result, err := f.usecase.Run(f.ctx, "post-1", 10)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []*discussion.Comment{nestedReply, reply, comment1}, result)
+	})
+
+	t.Run("It limits the number of comments", func(t *testing.T) {
+		f := setup()
+
+		newComment(f, "COMMENT_1", "post-1", "post-1", 0)
+		comment2 := newComment(f, "COMMENT_2", "post-1", "post-1", 1)
+		comment3 := newComment(f, "COMMENT_3", "post-1", "post-1", 2)
+
+		result, err := f.usecase.Run(f.ctx, "", 2)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []*discussion.Comment{comment3, comment2}, result)
+	})
+
+	t.Run("It leaves out the comments hidden from the thread and the deleted ones", func(t *testing.T) {
+		f := setup()
+
+		pending := NewComment(discussion.Comment{ID: "PENDING", SubjectID: "post-1", Status: discussion.CommentPending})
+		deleted := NewComment(discussion.Comment{ID: "DELETED", SubjectID: "post-1", DeletedAt: f.createdAt})
+		f.repo.SaveComment(f.ctx, pending)
+		f.repo.SaveComment(f.ctx, deleted)
+		replyToDeleted := newComment(f, "REPLY_TO_DELETED", "DELETED", "post-1", 0)
+
+		result, err := f.usecase.Run(f.ctx, "", 10)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []*discussion.Comment{replyToDeleted}, result)
+	})
+
+	t.Run("It keeps the replies to hidden comments, as the thread does", func(t *testing.T) {
+		f := setup()
+
+		rejected := NewComment(discussion.Comment{ID: "REJECTED", SubjectID: "post-1", Status: discussion.CommentRejected})
+		f.repo.SaveComment(f.ctx, rejected)
+		replyToRejected := newComment(f, "REPLY_TO_REJECTED", "REJECTED", "post-1", 0)
+
+		result, err := f.usecase.Run(f.ctx, "post-1", 10)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []*discussion.Comment{replyToRejected}, result)
+	})
+
+	t.Run("It leaves out the comments of banned authors", func(t *testing.T) {
+		f := setup()
+
+		f.author.Banned = true
+		newComment(f, "COMMENT_1", "post-1", "post-1", 0)
+
+		result, err := f.usecase.Run(f.ctx, "", 10)
+
+		assert.Nil(t, err)
+		assert.Empty(t, result)
+	})
+}
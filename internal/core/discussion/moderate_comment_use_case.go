@@ -0,0 +1,53 @@
+package discussion
+
+import (
+	"context"
+	"fmt"
+)
+
+type ModerateCommentUseCase struct {
+	commentRepo CommentRepo
+	authorizer  Authorizer
+}
+
+func NewModerateCommentUseCase(commentRepo CommentRepo, authorizer Authorizer) *ModerateCommentUseCase {
+	return &ModerateCommentUseCase{
+		commentRepo: commentRepo,
+		authorizer:  authorizer,
+	}
+}
+
+func (u *ModerateCommentUseCase) Run(ctx context.Context, moderatorUserID, commentID string, status CommentStatus) error {
+	canModerate, err := u.authorizer.CanModerateComments(ctx, moderatorUserID)
+	if err != nil {
+		return fmt.Errorf("error on ModerateCommentUseCase.Run when authorizing moderator: %w", err)
+	}
+
+	if !canModerate {
+		return ErrNotModerator
+	}
+
+	if !isModerationStatus(status) {
+		return ErrInvalidCommentStatus
+	}
+
+	comment, err := u.commentRepo.GetCommentByID(ctx, commentID)
+	if err != nil {
+		return fmt.Errorf("error on ModerateCommentUseCase.Run when finding comment: %w", err)
+	}
+
+	if comment == nil {
+		return ErrCommentNotFound
+	}
+
+	err = u.commentRepo.UpdateCommentStatus(ctx, comment.ID, status)
+	if err != nil {
+		return fmt.Errorf("error on ModerateCommentUseCase.Run when updating status: %w", err)
+	}
+
+	return nil
+}
+
+func isModerationStatus(status CommentStatus) bool {
+	return status == CommentApproved || status == CommentRejected || status == CommentSpam
+}
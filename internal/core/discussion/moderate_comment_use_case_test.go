@@ -0,0 +1,84 @@
+package discussion_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/geisonbiazus/blog/internal/adapters/commentrepo/memory"
+	"github.com/geisonbiazus/blog/internal/core/discussion"
+	. "github.com/geisonbiazus/blog/internal/core/discussion/test"
+	"github.com/stretchr/testify/suite"
+)
+
+type ModerateCommentUseCaseSuite struct {
+	suite.Suite
+	usecase    *discussion.ModerateCommentUseCase
+	repo       *memory.CommentRepo
+	authorizer *AuthorizerStub
+	ctx        context.Context
+	comment    *discussion.Comment
+}
+
+func (s *ModerateCommentUseCaseSuite) SetupSubTest() {
+	s.ctx = context.Background()
+	s.repo = memory.NewCommentRepo()
+	s.authorizer = NewAuthorizerStub()
+	s.usecase = discussion.NewModerateCommentUseCase(s.repo, s.authorizer)
+
+	s.comment = NewComment(discussion.Comment{Status: discussion.CommentPending})
+	s.repo.SaveComment(s.ctx, s.comment.Clone())
+}
+
+func (s *ModerateCommentUseCaseSuite) TestRun() {
+	s.Run("It updates the status of the comment", func() {
+		for _, status := range []discussion.CommentStatus{
+			discussion.CommentApproved,
+			discussion.CommentRejected,
+			discussion.CommentSpam,
+		} {
+			err := s.usecase.Run(s.ctx, "MODERATOR_ID", s.comment.ID, status)
+
+			comment, _ := s.repo.GetCommentByID(s.ctx, s.comment.ID)
+
+			s.Nil(err)
+			s.Equal(status, comment.Status)
+			s.Equal("MODERATOR_ID", s.authorizer.ReceivedUserID)
+		}
+	})
+
+	s.Run("It returns ErrNotModerator when the user can't moderate comments", func() {
+		s.authorizer.ReturnCanModerate = false
+
+		err := s.usecase.Run(s.ctx, "USER_ID", s.comment.ID, discussion.CommentApproved)
+
+		comment, _ := s.repo.GetCommentByID(s.ctx, s.comment.ID)
+
+		s.Equal(discussion.ErrNotModerator, err)
+		s.Equal(discussion.CommentPending, comment.Status)
+	})
+
+	s.Run("It returns error when authorizing fails", func() {
+		s.authorizer.ReturnError = errors.New("authorizer error")
+
+		err := s.usecase.Run(s.ctx, "MODERATOR_ID", s.comment.ID, discussion.CommentApproved)
+
+		s.ErrorIs(err, s.authorizer.ReturnError)
+	})
+
+	s.Run("It returns ErrInvalidCommentStatus when the status is not a moderation decision", func() {
+		err := s.usecase.Run(s.ctx, "MODERATOR_ID", s.comment.ID, discussion.CommentPending)
+
+		s.Equal(discussion.ErrInvalidCommentStatus, err)
+	})
+
+	s.Run("It returns ErrCommentNotFound when the comment doesn't exist", func() {
+		err := s.usecase.Run(s.ctx, "MODERATOR_ID", "UNKNOWN_ID", discussion.CommentApproved)
+
+		s.Equal(discussion.ErrCommentNotFound, err)
+	})
+}
+
+func TestModerateCommentUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(ModerateCommentUseCaseSuite))
+}
@@ -6,5 +6,31 @@ type CommentRepo interface {
 	SaveAuthor(ctx context.Context, author *Author) error
 	GetAuthorByID(ctx context.Context, id string) (*Author, error)
 	GetAuthorByUserID(ctx context.Context, userID string) (*Author, error)
-	GetCommentsAndRepliesRecursively(ctx context.Context, subjectID string) ([]*Comment, error)
+	SaveComment(ctx context.Context, comment *Comment) error
+	UpdateComment(ctx context.Context, comment *Comment) error
+	DeleteComment(ctx context.Context, id string) error
+	GetCommentByID(ctx context.Context, id string) (*Comment, error)
+	HasReplies(ctx context.Context, id string) (bool, error)
+	SaveCommentRevision(ctx context.Context, revision *CommentRevision) error
+	GetCommentRevisions(ctx context.Context, commentID string) ([]*CommentRevision, error)
+	GetCommentsAndRepliesRecursively(ctx context.Context, subjectID, viewerUserID string) ([]*Comment, error)
+	GetCommentsByStatus(ctx context.Context, status CommentStatus) ([]*Comment, error)
+	GetRecentComments(ctx context.Context, subjectID string, limit int) ([]*Comment, error)
+	UpdateCommentStatus(ctx context.Context, id string, status CommentStatus) error
+	HasApprovedComments(ctx context.Context, authorID string) (bool, error)
+}
+
+type Renderer interface {
+	Render(content string) (string, error)
+}
+
+// SubjectChecker tells whether a subject is open for comments, which is the
+// case for the published posts.
+type SubjectChecker interface {
+	IsOpenForComments(ctx context.Context, subjectID string) (bool, error)
+}
+
+// Authorizer tells whether a user's role allows them to moderate comments.
+type Authorizer interface {
+	CanModerateComments(ctx context.Context, userID string) (bool, error)
 }
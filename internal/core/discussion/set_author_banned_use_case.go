@@ -0,0 +1,50 @@
+package discussion
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/geisonbiazus/blog/internal/core/shared"
+)
+
+type SetAuthorBannedUseCase struct {
+	commentRepo CommentRepo
+	txManager   shared.TransactionManager
+}
+
+func NewSetAuthorBannedUseCase(commentRepo CommentRepo, txManager shared.TransactionManager) *SetAuthorBannedUseCase {
+	return &SetAuthorBannedUseCase{
+		commentRepo: commentRepo,
+		txManager:   txManager,
+	}
+}
+
+func (u *SetAuthorBannedUseCase) Run(ctx context.Context, userID string, banned bool) error {
+	return u.txManager.Transaction(ctx, func(ctx context.Context) error {
+		return u.run(ctx, userID, banned)
+	})
+}
+
+func (u *SetAuthorBannedUseCase) run(ctx context.Context, userID string, banned bool) error {
+	author, err := u.commentRepo.GetAuthorByUserID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("error on SetAuthorBannedUseCase.Run when finding author: %w", err)
+	}
+
+	if author == nil {
+		return ErrAuthorNotFound
+	}
+
+	if author.Banned == banned {
+		return nil
+	}
+
+	author.Banned = banned
+
+	err = u.commentRepo.SaveAuthor(ctx, author)
+	if err != nil {
+		return fmt.Errorf("error on SetAuthorBannedUseCase.Run when saving author: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package discussion_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/geisonbiazus/blog/internal/adapters/commentrepo/memory"
+	"github.com/geisonbiazus/blog/internal/adapters/transactionmanager"
+	"github.com/geisonbiazus/blog/internal/core/discussion"
+	. "github.com/geisonbiazus/blog/internal/core/discussion/test"
+	"github.com/stretchr/testify/suite"
+)
+
+type SetAuthorBannedUseCaseSuite struct {
+	suite.Suite
+	usecase *discussion.SetAuthorBannedUseCase
+	repo    *memory.CommentRepo
+	ctx     context.Context
+	author  *discussion.Author
+}
+
+func (s *SetAuthorBannedUseCaseSuite) SetupSubTest() {
+	s.ctx = context.Background()
+	s.repo = memory.NewCommentRepo()
+	s.usecase = discussion.NewSetAuthorBannedUseCase(s.repo, transactionmanager.NewFakeTransactionManager())
+
+	s.author = NewAuthor(discussion.Author{})
+	s.repo.SaveAuthor(s.ctx, s.author.Clone())
+}
+
+func (s *SetAuthorBannedUseCaseSuite) TestRun() {
+	s.Run("It bans and unbans the author of the given user", func() {
+		s.Nil(s.usecase.Run(s.ctx, s.author.UserID, true))
+
+		author, _ := s.repo.GetAuthorByUserID(s.ctx, s.author.UserID)
+		s.True(author.Banned)
+
+		s.Nil(s.usecase.Run(s.ctx, s.author.UserID, false))
+
+		author, _ = s.repo.GetAuthorByUserID(s.ctx, s.author.UserID)
+		s.False(author.Banned)
+	})
+
+	s.Run("It hides the comments of banned authors", func() {
+		s.repo.SaveComment(s.ctx, NewComment(discussion.Comment{AuthorID: s.author.ID}))
+
+		s.Nil(s.usecase.Run(s.ctx, s.author.UserID, true))
+
+		comments, _ := s.repo.GetCommentsAndRepliesRecursively(s.ctx, "SUBJECT_ID", "")
+		s.Empty(comments)
+	})
+
+	s.Run("It returns ErrAuthorNotFound when the author doesn't exist", func() {
+		err := s.usecase.Run(s.ctx, "UNKNOWN_USER_ID", true)
+
+		s.Equal(discussion.ErrAuthorNotFound, err)
+	})
+}
+
+func TestSetAuthorBannedUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(SetAuthorBannedUseCaseSuite))
+}
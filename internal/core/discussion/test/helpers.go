@@ -7,6 +7,8 @@ import (
 )
 
 func NewComment(params discussion.Comment) *discussion.Comment {
+	createdAt := valueOrDefault(params.CreatedAt, time.Now())
+
 	return &discussion.Comment{
 		ID:        valueOrDefault(params.ID, "COMMENT_ID"),
 		SubjectID: valueOrDefault(params.SubjectID, "SUBJECT_ID"),
@@ -14,8 +16,13 @@ func NewComment(params discussion.Comment) *discussion.Comment {
 		Author:    valueOrDefault(params.Author, nil),
 		Markdown:  valueOrDefault(params.Markdown, "Markdown"),
 		HTML:      valueOrDefault(params.HTML, "HTML"),
-		CreatedAt: valueOrDefault(params.CreatedAt, time.Now()),
+		Status:    valueOrDefault(params.Status, discussion.CommentApproved),
+		CreatedAt: createdAt,
+		UpdatedAt: valueOrDefault(params.UpdatedAt, createdAt),
+		DeletedAt: params.DeletedAt,
 		Replies:   sliceOrDefault(params.Replies, []*discussion.Comment{}),
+
+		RootSubjectID: params.RootSubjectID,
 	}
 }
 
@@ -0,0 +1,195 @@
+package cli
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/geisonbiazus/blog/internal/core/blog"
+)
+
+var ErrUsage = errors.New("usage: blogctl <new|lint|list|render> [arguments]")
+var ErrPostExists = errors.New("a post with this path already exists")
+
+// CLI implements the blogctl subcommands on top of the same adapters used by
+// the web server.
+type CLI struct {
+	postPath  string
+	postRepo  blog.PostRepo
+	renderer  blog.Renderer
+	validator PostValidator
+	formatter PostFormatter
+	out       io.Writer
+}
+
+func New(
+	postPath string,
+	postRepo blog.PostRepo,
+	renderer blog.Renderer,
+	validator PostValidator,
+	formatter PostFormatter,
+	out io.Writer,
+) *CLI {
+	return &CLI{
+		postPath:  postPath,
+		postRepo:  postRepo,
+		renderer:  renderer,
+		validator: validator,
+		formatter: formatter,
+		out:       out,
+	}
+}
+
+func (c *CLI) Run(args []string) error {
+	if len(args) == 0 {
+		return ErrUsage
+	}
+
+	switch args[0] {
+	case "new":
+		return c.newPost(args[1:])
+	case "lint":
+		return c.lint()
+	case "list":
+		return c.list()
+	case "render":
+		return c.render(args[1:])
+	default:
+		return ErrUsage
+	}
+}
+
+func (c *CLI) newPost(args []string) error {
+	flags := flag.NewFlagSet("new", flag.ContinueOnError)
+	flags.SetOutput(c.out)
+	author := flags.String("author", "", "post author")
+	description := flags.String("description", "", "post description")
+	tags := flags.String("tags", "", "comma separated list of tags")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	title := strings.Join(flags.Args(), " ")
+	path := blog.Slugify(title)
+
+	if path == "" {
+		return errors.New("usage: blogctl new [-author name] [-description text] [-tags a,b] <title>")
+	}
+
+	post := blog.Post{
+		Title:       title,
+		Author:      *author,
+		Description: *description,
+		Time:        time.Now().UTC().Truncate(time.Minute),
+		Status:      blog.PostDraft,
+		Markdown:    "Write your post here.\n",
+	}
+
+	post.Tags = splitTags(*tags)
+
+	file := filepath.Join(c.postPath, path+".md")
+
+	if _, err := os.Stat(file); err == nil {
+		return fmt.Errorf("%w: %s", ErrPostExists, file)
+	}
+
+	if err := os.WriteFile(file, []byte(c.formatter.Format(post)), 0644); err != nil {
+		return fmt.Errorf("error on blogctl new when writing %s: %w", file, err)
+	}
+
+	fmt.Fprintln(c.out, file)
+	return nil
+}
+
+// splitTags trims the comma separated tags, leaving out the empty ones.
+func splitTags(tags string) []string {
+	result := []string{}
+
+	for _, tag := range strings.Split(tags, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			result = append(result, tag)
+		}
+	}
+
+	if len(result) == 0 {
+		return nil
+	}
+
+	return result
+}
+
+func (c *CLI) lint() error {
+	diagnostics, err := c.validator.Validate()
+
+	if err != nil {
+		return err
+	}
+
+	for _, diagnostic := range diagnostics {
+		fmt.Fprintln(c.out, diagnostic)
+	}
+
+	if len(diagnostics) > 0 {
+		return fmt.Errorf("%d problem(s) found in posts", len(diagnostics))
+	}
+
+	return nil
+}
+
+func (c *CLI) list() error {
+	posts, err := c.postRepo.GetAllPosts()
+
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	w := tabwriter.NewWriter(c.out, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(w, "DATE\tSTATUS\tPATH\tTITLE")
+
+	for _, post := range posts {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", post.Time.Format("2006-01-02 15:04"), status(post, now), post.Path, post.Title)
+	}
+
+	return w.Flush()
+}
+
+func status(post blog.Post, now time.Time) string {
+	switch {
+	case post.IsDraft():
+		return "draft"
+	case post.IsScheduled(now):
+		return "scheduled"
+	default:
+		return "published"
+	}
+}
+
+func (c *CLI) render(args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: blogctl render <path>")
+	}
+
+	post, err := c.postRepo.GetPostByPath(strings.TrimSuffix(filepath.Base(args[0]), ".md"))
+
+	if err != nil {
+		return err
+	}
+
+	html, _, err := c.renderer.Render(post.Markdown)
+
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprint(c.out, html)
+	return nil
+}
@@ -0,0 +1,164 @@
+package cli_test
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/geisonbiazus/blog/internal/adapters/postrepo/filesystem"
+	"github.com/geisonbiazus/blog/internal/core/blog"
+	"github.com/geisonbiazus/blog/internal/ui/cli"
+	"github.com/stretchr/testify/assert"
+)
+
+type cliFixture struct {
+	cli       *cli.CLI
+	postPath  string
+	renderer  *rendererSpy
+	validator *postValidatorSpy
+	out       *bytes.Buffer
+}
+
+func TestCLI(t *testing.T) {
+	setup := func(t *testing.T) *cliFixture {
+		postPath := t.TempDir()
+		renderer := &rendererSpy{}
+		validator := &postValidatorSpy{}
+		out := &bytes.Buffer{}
+
+		return &cliFixture{
+			cli:       cli.New(postPath, filesystem.NewPostRepo(postPath), renderer, validator, filesystem.NewFormatter(), out),
+			postPath:  postPath,
+			renderer:  renderer,
+			validator: validator,
+			out:       out,
+		}
+	}
+
+	writePost := func(t *testing.T, f *cliFixture, path string, post blog.Post) {
+		err := os.WriteFile(filepath.Join(f.postPath, path+".md"), []byte(filesystem.FormatFileContent(post)), 0644)
+		assert.Nil(t, err)
+	}
+
+	t.Run("It returns the usage when the command is unknown", func(t *testing.T) {
+		f := setup(t)
+
+		assert.Equal(t, cli.ErrUsage, f.cli.Run([]string{}))
+		assert.Equal(t, cli.ErrUsage, f.cli.Run([]string{"unknown"}))
+	})
+
+	t.Run("new scaffolds a draft post with a slug from the title", func(t *testing.T) {
+		f := setup(t)
+
+		err := f.cli.Run([]string{"new", "-author", "Author Name", "-tags", "go, testing,", "Hello,", "World!"})
+
+		assert.Nil(t, err)
+		file := filepath.Join(f.postPath, "hello-world.md")
+		assert.Equal(t, file+"\n", f.out.String())
+
+		post, err := filesystem.NewPostRepo(f.postPath).GetPostByPath("hello-world")
+
+		assert.Nil(t, err)
+		assert.Equal(t, "Hello, World!", post.Title)
+		assert.Equal(t, "Author Name", post.Author)
+		assert.Equal(t, []string{"go", "testing"}, post.Tags)
+		assert.Equal(t, blog.PostDraft, post.Status)
+		assert.WithinDuration(t, time.Now(), post.Time, 2*time.Minute)
+	})
+
+	t.Run("new refuses to overwrite an existing post", func(t *testing.T) {
+		f := setup(t)
+		writePost(t, f, "hello-world", blog.Post{Title: "Existing"})
+
+		err := f.cli.Run([]string{"new", "Hello World"})
+
+		assert.ErrorIs(t, err, cli.ErrPostExists)
+	})
+
+	t.Run("new requires a title", func(t *testing.T) {
+		f := setup(t)
+
+		assert.NotNil(t, f.cli.Run([]string{"new"}))
+	})
+
+	t.Run("lint prints the diagnostics and fails when there are problems", func(t *testing.T) {
+		f := setup(t)
+		f.validator.ReturnDiagnostics = []blog.Diagnostic{
+			{File: "posts/a.md", Line: 3, Message: "missing title"},
+		}
+
+		err := f.cli.Run([]string{"lint"})
+
+		assert.EqualError(t, err, "1 problem(s) found in posts")
+		assert.Equal(t, "posts/a.md:3: missing title\n", f.out.String())
+	})
+
+	t.Run("lint succeeds when there are no problems", func(t *testing.T) {
+		f := setup(t)
+
+		assert.Nil(t, f.cli.Run([]string{"lint"}))
+		assert.Empty(t, f.out.String())
+	})
+
+	t.Run("lint returns the validator error", func(t *testing.T) {
+		f := setup(t)
+		f.validator.ReturnError = errors.New("read error")
+
+		assert.Equal(t, f.validator.ReturnError, f.cli.Run([]string{"lint"}))
+	})
+
+	t.Run("list shows every post with its date and status", func(t *testing.T) {
+		f := setup(t)
+		writePost(t, f, "published", blog.Post{Title: "Published Post", Time: time.Date(2021, 4, 5, 18, 47, 0, 0, time.UTC)})
+		writePost(t, f, "draft", blog.Post{Title: "Draft Post", Time: time.Date(2021, 4, 4, 10, 0, 0, 0, time.UTC), Status: blog.PostDraft})
+		writePost(t, f, "scheduled", blog.Post{Title: "Scheduled Post", Time: time.Now().Add(48 * time.Hour).UTC()})
+
+		err := f.cli.Run([]string{"list"})
+
+		assert.Nil(t, err)
+		assert.Regexp(t, `(?m)^DATE\s+STATUS\s+PATH\s+TITLE$`, f.out.String())
+		assert.Regexp(t, `(?m)^\S+ \S+\s+scheduled\s+scheduled\s+Scheduled Post$`, f.out.String())
+		assert.Regexp(t, `(?m)^2021-04-05 18:47\s+published\s+published\s+Published Post$`, f.out.String())
+		assert.Regexp(t, `(?m)^2021-04-04 10:00\s+draft\s+draft\s+Draft Post$`, f.out.String())
+	})
+
+	t.Run("render prints the HTML of the post", func(t *testing.T) {
+		f := setup(t)
+		writePost(t, f, "post", blog.Post{Title: "Post", Markdown: "Content\n"})
+		f.renderer.ReturnHTML = "<p>Content</p>\n"
+
+		err := f.cli.Run([]string{"render", "post.md"})
+
+		assert.Nil(t, err)
+		assert.Equal(t, "Content\n", f.renderer.ReceivedContent)
+		assert.Equal(t, "<p>Content</p>\n", f.out.String())
+	})
+
+	t.Run("render returns error when the post doesn't exist", func(t *testing.T) {
+		f := setup(t)
+
+		assert.Equal(t, blog.ErrPostNotFound, f.cli.Run([]string{"render", "unknown"}))
+	})
+}
+
+type rendererSpy struct {
+	ReceivedContent string
+	ReturnHTML      string
+}
+
+func (r *rendererSpy) Render(content string) (string, []blog.Heading, error) {
+	r.ReceivedContent = content
+	return r.ReturnHTML, nil, nil
+}
+
+type postValidatorSpy struct {
+	ReturnDiagnostics []blog.Diagnostic
+	ReturnError       error
+}
+
+func (v *postValidatorSpy) Validate() ([]blog.Diagnostic, error) {
+	return v.ReturnDiagnostics, v.ReturnError
+}
@@ -0,0 +1,13 @@
+package cli
+
+import "github.com/geisonbiazus/blog/internal/core/blog"
+
+type PostValidator interface {
+	Validate() ([]blog.Diagnostic, error)
+}
+
+// PostFormatter writes the post in the format of the post files, so scaffolded
+// posts can be read back by the post repo.
+type PostFormatter interface {
+	Format(post blog.Post) string
+}
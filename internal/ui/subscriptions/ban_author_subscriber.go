@@ -0,0 +1,29 @@
+package subscriptions
+
+import (
+	"context"
+
+	"github.com/geisonbiazus/blog/internal/core/auth"
+	"github.com/geisonbiazus/blog/internal/core/shared"
+)
+
+type BanAuthorSubscriber struct {
+	*BaseSubscriber
+	usecase SetAuthorBannedUseCase
+}
+
+func NewBanAuthorSubscriber(usecase SetAuthorBannedUseCase, subscriber Subscriber) *BanAuthorSubscriber {
+	return &BanAuthorSubscriber{
+		BaseSubscriber: NewBaseSubscriber(subscriber, auth.UserRoleChangedEvent),
+		usecase:        usecase,
+	}
+}
+
+func (s *BanAuthorSubscriber) Start() {
+	s.BaseSubscriber.Start(func(event shared.Event) error {
+		userID := event.Payload["ID"].(string)
+		banned := auth.Role(event.Payload["Role"].(string)) == auth.RoleBanned
+
+		return s.usecase.Run(context.Background(), userID, banned)
+	})
+}
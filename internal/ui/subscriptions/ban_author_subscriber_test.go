@@ -0,0 +1,73 @@
+package subscriptions_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/geisonbiazus/blog/internal/core/auth"
+	"github.com/geisonbiazus/blog/internal/core/shared"
+	"github.com/geisonbiazus/blog/internal/ui/subscriptions"
+	"github.com/stretchr/testify/suite"
+)
+
+type BanAuthorSubscriberSuite struct {
+	suite.Suite
+	banAuthorSubscriber *subscriptions.BanAuthorSubscriber
+	usecase             *SetAuthorBannedUseCaseSpy
+	subscriber          *SubscriberSpy
+}
+
+func (s *BanAuthorSubscriberSuite) SetupSubTest() {
+	s.usecase = NewSetAuthorBannedUseCaseSpy()
+	s.subscriber = NewSubscriberSpy()
+	s.banAuthorSubscriber = subscriptions.NewBanAuthorSubscriber(s.usecase, s.subscriber)
+}
+
+func (s *BanAuthorSubscriberSuite) TestStart() {
+	s.Run("It bans the author when the user is banned", func() {
+		s.banAuthorSubscriber.Start()
+		s.subscriber.Publish(s.event("banned"))
+
+		s.True(<-s.usecase.Ran)
+		s.Equal("ID", s.usecase.ReceivedUserID)
+		s.True(s.usecase.ReceivedBanned)
+	})
+
+	s.Run("It unbans the author when the user gets any other role", func() {
+		s.banAuthorSubscriber.Start()
+		s.subscriber.Publish(s.event("commenter"))
+
+		s.True(<-s.usecase.Ran)
+		s.Equal("ID", s.usecase.ReceivedUserID)
+		s.False(s.usecase.ReceivedBanned)
+	})
+
+	s.Run("It notifies the subscriber in case of error", func() {
+		s.usecase.ReturnError = errors.New("error")
+		event := s.event("banned")
+		s.banAuthorSubscriber.Start()
+		s.subscriber.Publish(event)
+
+		s.True(<-s.usecase.Ran)
+		s.True(<-s.subscriber.Notified)
+		s.Equal(event, s.subscriber.NotifyErrorReceivedEvent)
+		s.Equal(s.usecase.ReturnError, s.subscriber.NotifyErrorReceivedError)
+	})
+}
+
+func (s *BanAuthorSubscriberSuite) event(role string) shared.Event {
+	return shared.Event{
+		Type:       auth.UserRoleChangedEvent,
+		OccurredOn: time.Now(),
+		Payload: map[string]interface{}{
+			"ID":           "ID",
+			"Role":         role,
+			"PreviousRole": "commenter",
+		},
+	}
+}
+
+func TestBanAuthorSubscriberSuite(t *testing.T) {
+	suite.Run(t, new(BanAuthorSubscriberSuite))
+}
@@ -28,6 +28,28 @@ func (s *SaveAuthorUseCaseSpy) Run(ctx context.Context, input discussion.SaveAut
 	return s.ReturnAuthor, s.ReturnError
 }
 
+type SetAuthorBannedUseCaseSpy struct {
+	Ran             chan bool
+	ReceivedContext context.Context
+	ReceivedUserID  string
+	ReceivedBanned  bool
+	ReturnError     error
+}
+
+func NewSetAuthorBannedUseCaseSpy() *SetAuthorBannedUseCaseSpy {
+	return &SetAuthorBannedUseCaseSpy{
+		Ran: make(chan bool),
+	}
+}
+
+func (s *SetAuthorBannedUseCaseSpy) Run(ctx context.Context, userID string, banned bool) error {
+	s.ReceivedContext = ctx
+	s.ReceivedUserID = userID
+	s.ReceivedBanned = banned
+	s.Ran <- true
+	return s.ReturnError
+}
+
 type SubscriberSpy struct {
 	channel                    chan shared.Event
 	SubscribeReceivedEventType string
@@ -14,9 +14,14 @@ type Subscriber interface {
 }
 
 type UseCases struct {
-	SaveAuthor SaveAuthorUseCase
+	SaveAuthor      SaveAuthorUseCase
+	SetAuthorBanned SetAuthorBannedUseCase
 }
 
 type SaveAuthorUseCase interface {
 	Run(ctx context.Context, input discussion.SaveAuthorInput) (author *discussion.Author, err error)
 }
+
+type SetAuthorBannedUseCase interface {
+	Run(ctx context.Context, userID string, banned bool) error
+}
@@ -12,4 +12,5 @@ func New(subscriber Subscriber, usecases *UseCases) *Subscriptions {
 func (s *Subscriptions) Start() {
 	NewSaveAuthorSubscriber(s.usecases.SaveAuthor, s.subscriber).Start()
 	NewUpdateAuthorSubscriber(s.usecases.SaveAuthor, s.subscriber).Start()
+	NewBanAuthorSubscriber(s.usecases.SetAuthorBanned, s.subscriber).Start()
 }
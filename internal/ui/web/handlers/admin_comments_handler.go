@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"errors"
+	"html/template"
+	"net/http"
+
+	"github.com/geisonbiazus/blog/internal/core/auth"
+	"github.com/geisonbiazus/blog/internal/core/discussion"
+	"github.com/geisonbiazus/blog/internal/ui/web/lib"
+	"github.com/geisonbiazus/blog/internal/ui/web/ports"
+)
+
+type AdminCommentsHandler struct {
+	usecase  ports.ListPendingCommentsUseCase
+	template *lib.TemplateRenderer
+}
+
+func NewAdminCommentsHandler(usecase ports.ListPendingCommentsUseCase, templateRenderer *lib.TemplateRenderer) *AdminCommentsHandler {
+	return &AdminCommentsHandler{
+		usecase:  usecase,
+		template: templateRenderer,
+	}
+}
+
+func (h *AdminCommentsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Admin pages are reported as not found to anyone who can't use them.
+	user, ok := lib.CurrentUser(r.Context())
+	if !ok || !user.Can(auth.PermissionModerateComments) {
+		h.respondWithNotFound(w, r)
+		return
+	}
+
+	comments, err := h.usecase.Run(r.Context(), user.ID)
+	if errors.Is(err, discussion.ErrNotModerator) {
+		h.respondWithNotFound(w, r)
+		return
+	}
+
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		h.template.Render(w, r, "500.html", nil)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	h.template.Render(w, r, "admin_comments.html", h.toViewModelList(comments))
+}
+
+func (h *AdminCommentsHandler) respondWithNotFound(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotFound)
+	h.template.Render(w, r, "404.html", nil)
+}
+
+func (h *AdminCommentsHandler) toViewModelList(comments []*discussion.Comment) []pendingCommentViewModel {
+	models := []pendingCommentViewModel{}
+
+	for _, comment := range comments {
+		models = append(models, pendingCommentViewModel{
+			ID:              comment.ID,
+			SubjectID:       comment.SubjectID,
+			AuthorUserID:    comment.Author.UserID,
+			AuthorAvatarURL: comment.Author.AvatarURL,
+			AuthorName:      comment.Author.Name,
+			Date:            comment.CreatedAt.Format(lib.DateFormat),
+			Content:         template.HTML(comment.HTML),
+		})
+	}
+
+	return models
+}
+
+type pendingCommentViewModel struct {
+	ID              string
+	SubjectID       string
+	AuthorUserID    string
+	AuthorAvatarURL string
+	AuthorName      string
+	Date            string
+	Content         template.HTML
+}
@@ -0,0 +1,130 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/geisonbiazus/blog/internal/core/auth"
+	"github.com/geisonbiazus/blog/internal/core/discussion"
+	. "github.com/geisonbiazus/blog/internal/core/discussion/test"
+	"github.com/geisonbiazus/blog/internal/ui/web/handlers"
+	"github.com/geisonbiazus/blog/internal/ui/web/lib"
+	"github.com/geisonbiazus/blog/internal/ui/web/test"
+	"github.com/geisonbiazus/blog/pkg/testhelper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminCommentsHandler(t *testing.T) {
+	type fixture struct {
+		handler *handlers.AdminCommentsHandler
+		usecase *listPendingCommentsUseCaseSpy
+	}
+
+	setup := func() fixture {
+		usecase := &listPendingCommentsUseCaseSpy{}
+		handler := handlers.NewAdminCommentsHandler(usecase, test.NewTestTemplateRenderer())
+
+		return fixture{handler: handler, usecase: usecase}
+	}
+
+	newRequest := func(user auth.User) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/admin/comments", nil)
+		return req.WithContext(lib.WithCurrentUser(req.Context(), user))
+	}
+
+	t.Run("It lists the pending comments with the moderation actions", func(t *testing.T) {
+		f := setup()
+		author := NewAuthor(discussion.Author{Name: "Pending Author"})
+		f.usecase.ReturnComments = []*discussion.Comment{
+			NewComment(discussion.Comment{Author: author, HTML: "<p>Pending comment</p>", Status: discussion.CommentPending}),
+		}
+
+		res := test.DoRequest(f.handler, newRequest(auth.User{ID: "USER_ID", Role: auth.RoleAdmin}))
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, "USER_ID", f.usecase.ReceivedModeratorUserID)
+		assert.Contains(t, body, "Pending Author")
+		assert.Contains(t, body, "<p>Pending comment</p>")
+		assert.Contains(t, body, `action="/admin/comments/moderate"`)
+	})
+
+	t.Run("It lets moderators see the queue without the ban action", func(t *testing.T) {
+		f := setup()
+		f.usecase.ReturnComments = []*discussion.Comment{
+			NewComment(discussion.Comment{Author: NewAuthor(discussion.Author{}), Status: discussion.CommentPending}),
+		}
+
+		res := test.DoRequest(f.handler, newRequest(auth.User{ID: "USER_ID", Role: auth.RoleModerator}))
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Contains(t, body, `action="/admin/comments/moderate"`)
+		assert.NotContains(t, body, `action="/admin/users/role"`)
+	})
+
+	t.Run("It renders the ban action for admins", func(t *testing.T) {
+		f := setup()
+		f.usecase.ReturnComments = []*discussion.Comment{
+			NewComment(discussion.Comment{Author: NewAuthor(discussion.Author{UserID: "AUTHOR_USER_ID"}), Status: discussion.CommentPending}),
+		}
+
+		res := test.DoRequest(f.handler, newRequest(auth.User{ID: "USER_ID", Role: auth.RoleAdmin}))
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Contains(t, body, `action="/admin/users/role"`)
+		assert.Contains(t, body, `value="AUTHOR_USER_ID"`)
+	})
+
+	t.Run("It responds with 404 when the current user can't moderate", func(t *testing.T) {
+		f := setup()
+
+		res := test.DoRequest(f.handler, newRequest(auth.User{ID: "USER_ID", Role: auth.RoleCommenter}))
+
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+		assert.False(t, f.usecase.Called)
+	})
+
+	t.Run("It responds with 404 when there is no session", func(t *testing.T) {
+		f := setup()
+
+		res := test.DoGetRequest(f.handler, "/admin/comments")
+
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+		assert.False(t, f.usecase.Called)
+	})
+
+	t.Run("It responds with 404 when the use case rejects the moderator", func(t *testing.T) {
+		f := setup()
+		f.usecase.ReturnError = discussion.ErrNotModerator
+
+		res := test.DoRequest(f.handler, newRequest(auth.User{ID: "USER_ID", Role: auth.RoleAdmin}))
+
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+	})
+
+	t.Run("It responds with 500 when an error is returned", func(t *testing.T) {
+		f := setup()
+		f.usecase.ReturnError = errors.New("some error")
+
+		res := test.DoRequest(f.handler, newRequest(auth.User{ID: "USER_ID", Role: auth.RoleAdmin}))
+
+		assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+	})
+}
+
+type listPendingCommentsUseCaseSpy struct {
+	Called                  bool
+	ReceivedModeratorUserID string
+	ReturnComments          []*discussion.Comment
+	ReturnError             error
+}
+
+func (u *listPendingCommentsUseCaseSpy) Run(ctx context.Context, moderatorUserID string) ([]*discussion.Comment, error) {
+	u.Called = true
+	u.ReceivedModeratorUserID = moderatorUserID
+	return u.ReturnComments, u.ReturnError
+}
@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/geisonbiazus/blog/internal/core/auth"
+	"github.com/geisonbiazus/blog/internal/core/blog"
+	"github.com/geisonbiazus/blog/internal/ui/web/lib"
+	"github.com/geisonbiazus/blog/internal/ui/web/ports"
+)
+
+type AdminDraftsHandler struct {
+	usecase  ports.ListPostPreviewsUseCase
+	template *lib.TemplateRenderer
+}
+
+func NewAdminDraftsHandler(usecase ports.ListPostPreviewsUseCase, templateRenderer *lib.TemplateRenderer) *AdminDraftsHandler {
+	return &AdminDraftsHandler{
+		usecase:  usecase,
+		template: templateRenderer,
+	}
+}
+
+func (h *AdminDraftsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !lib.CurrentUserCan(r.Context(), auth.PermissionPreviewPosts) {
+		w.WriteHeader(http.StatusNotFound)
+		h.template.Render(w, r, "404.html", nil)
+		return
+	}
+
+	previews, err := h.usecase.Run()
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		h.template.Render(w, r, "500.html", nil)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	h.template.Render(w, r, "admin_drafts.html", h.toViewModelList(previews))
+}
+
+func (h *AdminDraftsHandler) toViewModelList(previews []blog.PostPreview) []postPreviewViewModel {
+	models := []postPreviewViewModel{}
+	now := time.Now()
+
+	for _, preview := range previews {
+		models = append(models, postPreviewViewModel{
+			Title:       preview.Post.Title,
+			Status:      h.status(preview.Post, now),
+			PreviewPath: previewPath(preview),
+		})
+	}
+
+	return models
+}
+
+func (h *AdminDraftsHandler) status(post blog.Post, now time.Time) string {
+	if post.IsScheduled(now) {
+		return fmt.Sprintf("Scheduled for %s", post.Time.Format(lib.DateFormat))
+	}
+
+	return "Draft"
+}
+
+func previewPath(preview blog.PostPreview) string {
+	return fmt.Sprintf("/preview/%s?signature=%s", url.PathEscape(preview.Post.Path), url.QueryEscape(preview.Signature))
+}
+
+type postPreviewViewModel struct {
+	Title       string
+	Status      string
+	PreviewPath string
+}
@@ -0,0 +1,77 @@
+package handlers_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/geisonbiazus/blog/internal/core/auth"
+	"github.com/geisonbiazus/blog/internal/core/blog"
+	"github.com/geisonbiazus/blog/internal/ui/web/handlers"
+	"github.com/geisonbiazus/blog/internal/ui/web/lib"
+	"github.com/geisonbiazus/blog/internal/ui/web/test"
+	"github.com/geisonbiazus/blog/pkg/testhelper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminDraftsHandler(t *testing.T) {
+	setup := func() (*handlers.AdminDraftsHandler, *listPostPreviewsUseCaseSpy) {
+		usecase := &listPostPreviewsUseCaseSpy{}
+		handler := handlers.NewAdminDraftsHandler(usecase, test.NewTestTemplateRenderer())
+		return handler, usecase
+	}
+
+	newRequest := func(user auth.User) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/admin/drafts", nil)
+		return req.WithContext(lib.WithCurrentUser(req.Context(), user))
+	}
+
+	t.Run("It lists the drafts and scheduled posts with their preview links", func(t *testing.T) {
+		handler, usecase := setup()
+		scheduledTime := time.Now().Add(24 * time.Hour)
+		usecase.ReturnPreviews = []blog.PostPreview{
+			{Post: blog.Post{Title: "Draft Post", Path: "draft-post", Status: blog.PostDraft}, Signature: "abc+/="},
+			{Post: blog.Post{Title: "Scheduled Post", Path: "scheduled-post", Time: scheduledTime}, Signature: "def"},
+		}
+
+		res := test.DoRequest(handler, newRequest(auth.User{ID: "USER_ID", Role: auth.RoleAdmin}))
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Contains(t, body, "Draft Post")
+		assert.Contains(t, body, `href="/preview/draft-post?signature=abc%2B%2F%3D"`)
+		assert.Contains(t, body, "Scheduled Post")
+		assert.Contains(t, body, "Scheduled for "+scheduledTime.Format(lib.DateFormat))
+	})
+
+	t.Run("It responds with not found to users who can't preview posts", func(t *testing.T) {
+		handler, usecase := setup()
+
+		res := test.DoRequest(handler, newRequest(auth.User{ID: "USER_ID", Role: auth.RoleModerator}))
+
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+		assert.False(t, usecase.Called)
+	})
+
+	t.Run("It responds with server error when the use case fails", func(t *testing.T) {
+		handler, usecase := setup()
+		usecase.ReturnError = errors.New("any error")
+
+		res := test.DoRequest(handler, newRequest(auth.User{ID: "USER_ID", Role: auth.RoleAdmin}))
+
+		assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+	})
+}
+
+type listPostPreviewsUseCaseSpy struct {
+	Called         bool
+	ReturnPreviews []blog.PostPreview
+	ReturnError    error
+}
+
+func (u *listPostPreviewsUseCaseSpy) Run() ([]blog.PostPreview, error) {
+	u.Called = true
+	return u.ReturnPreviews, u.ReturnError
+}
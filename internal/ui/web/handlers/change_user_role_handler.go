@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/geisonbiazus/blog/internal/core/auth"
+	"github.com/geisonbiazus/blog/internal/ui/web/lib"
+	"github.com/geisonbiazus/blog/internal/ui/web/ports"
+)
+
+type ChangeUserRoleHandler struct {
+	usecase  ports.ChangeUserRoleUseCase
+	template *lib.TemplateRenderer
+}
+
+func NewChangeUserRoleHandler(usecase ports.ChangeUserRoleUseCase, templateRenderer *lib.TemplateRenderer) *ChangeUserRoleHandler {
+	return &ChangeUserRoleHandler{
+		usecase:  usecase,
+		template: templateRenderer,
+	}
+}
+
+func (h *ChangeUserRoleHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	user, ok := lib.CurrentUser(r.Context())
+	if !ok {
+		h.respondWithNotFound(w, r)
+		return
+	}
+
+	role := auth.Role(r.PostFormValue("role"))
+
+	err := h.usecase.Run(r.Context(), user, r.PostFormValue("user_id"), role)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	http.Redirect(w, r, "/admin/comments", http.StatusSeeOther)
+}
+
+func (h *ChangeUserRoleHandler) handleError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, auth.ErrForbidden) || errors.Is(err, auth.ErrUserNotFound) {
+		h.respondWithNotFound(w, r)
+		return
+	}
+
+	if errors.Is(err, auth.ErrInvalidRole) {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	w.WriteHeader(http.StatusInternalServerError)
+	h.template.Render(w, r, "500.html", nil)
+}
+
+func (h *ChangeUserRoleHandler) respondWithNotFound(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotFound)
+	h.template.Render(w, r, "404.html", nil)
+}
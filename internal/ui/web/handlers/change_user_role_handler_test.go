@@ -0,0 +1,113 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/geisonbiazus/blog/internal/core/auth"
+	"github.com/geisonbiazus/blog/internal/ui/web/handlers"
+	"github.com/geisonbiazus/blog/internal/ui/web/lib"
+	"github.com/geisonbiazus/blog/internal/ui/web/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChangeUserRoleHandler(t *testing.T) {
+	type fixture struct {
+		handler *handlers.ChangeUserRoleHandler
+		usecase *changeUserRoleUseCaseSpy
+	}
+
+	setup := func() fixture {
+		usecase := &changeUserRoleUseCaseSpy{}
+		handler := handlers.NewChangeUserRoleHandler(usecase, test.NewTestTemplateRenderer())
+
+		return fixture{handler: handler, usecase: usecase}
+	}
+
+	admin := auth.User{ID: "ADMIN_ID", Role: auth.RoleAdmin}
+
+	form := url.Values{
+		"user_id": {"USER_ID"},
+		"role":    {"banned"},
+	}
+
+	newRequest := func() *http.Request {
+		req := test.NewPostFormRequest("/admin/users/role", form)
+		return req.WithContext(lib.WithCurrentUser(req.Context(), admin))
+	}
+
+	t.Run("It changes the role of the user and redirects to the moderation queue", func(t *testing.T) {
+		f := setup()
+
+		res := test.DoRequest(f.handler, newRequest())
+
+		assert.Equal(t, admin, f.usecase.ReceivedActor)
+		assert.Equal(t, "USER_ID", f.usecase.ReceivedUserID)
+		assert.Equal(t, auth.RoleBanned, f.usecase.ReceivedRole)
+		assert.Equal(t, http.StatusSeeOther, res.StatusCode)
+		assert.Equal(t, "/admin/comments", res.Header.Get("Location"))
+	})
+
+	t.Run("It responds with 404 when there is no session", func(t *testing.T) {
+		f := setup()
+
+		res := test.DoRequest(f.handler, test.NewPostFormRequest("/admin/users/role", form))
+
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+		assert.Equal(t, "", f.usecase.ReceivedUserID)
+	})
+
+	t.Run("It responds with 404 when the current user is not allowed", func(t *testing.T) {
+		f := setup()
+		f.usecase.ReturnError = auth.ErrForbidden
+
+		res := test.DoRequest(f.handler, newRequest())
+
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+	})
+
+	t.Run("It responds with 400 when the role is invalid", func(t *testing.T) {
+		f := setup()
+		f.usecase.ReturnError = auth.ErrInvalidRole
+
+		res := test.DoRequest(f.handler, newRequest())
+
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+	})
+
+	t.Run("It responds with 500 when an unrecognized error is returned", func(t *testing.T) {
+		f := setup()
+		f.usecase.ReturnError = errors.New("some error")
+
+		res := test.DoRequest(f.handler, newRequest())
+
+		assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+	})
+
+	t.Run("It responds with 405 when the method is not POST", func(t *testing.T) {
+		f := setup()
+
+		res := test.DoGetRequest(f.handler, "/admin/users/role")
+
+		assert.Equal(t, http.StatusMethodNotAllowed, res.StatusCode)
+	})
+}
+
+type changeUserRoleUseCaseSpy struct {
+	ReceivedContext context.Context
+	ReceivedActor   auth.User
+	ReceivedUserID  string
+	ReceivedRole    auth.Role
+	ReturnError     error
+}
+
+func (u *changeUserRoleUseCaseSpy) Run(ctx context.Context, actor auth.User, userID string, role auth.Role) error {
+	u.ReceivedContext = ctx
+	u.ReceivedActor = actor
+	u.ReceivedUserID = userID
+	u.ReceivedRole = role
+	return u.ReturnError
+}
@@ -0,0 +1,170 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/geisonbiazus/blog/internal/core/blog"
+	"github.com/geisonbiazus/blog/internal/core/discussion"
+	"github.com/geisonbiazus/blog/internal/ui/web/lib"
+	"github.com/geisonbiazus/blog/internal/ui/web/ports"
+	"github.com/gorilla/feeds"
+)
+
+// CommentsFeedHandler serves the Atom feed of the recent comments of the blog
+// on /comments.atom and of a single post on /posts/{path}/comments.atom.
+type CommentsFeedHandler struct {
+	listRecentComments ports.ListRecentCommentsUseCase
+	viewPost           ports.ViewPostUseCase
+	template           *lib.TemplateRenderer
+	baseURL            string
+	config             FeedConfig
+}
+
+func NewCommentsFeedHandler(
+	listRecentComments ports.ListRecentCommentsUseCase,
+	viewPost ports.ViewPostUseCase,
+	templateRenderer *lib.TemplateRenderer,
+	baseURL string,
+	config FeedConfig,
+) *CommentsFeedHandler {
+	return &CommentsFeedHandler{
+		listRecentComments: listRecentComments,
+		viewPost:           viewPost,
+		template:           templateRenderer,
+		baseURL:            baseURL,
+		config:             config,
+	}
+}
+
+const recentCommentsLimit = 20
+
+// IsCommentsFeedPath tells whether the path points to a comments feed.
+func IsCommentsFeedPath(feedPath string) bool {
+	return path.Base(feedPath) == "comments.atom"
+}
+
+func (h *CommentsFeedHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !IsCommentsFeedPath(r.URL.Path) {
+		h.renderNotFound(w, r)
+		return
+	}
+
+	feed := &feeds.Feed{
+		Id:          h.baseURL + r.URL.Path,
+		Title:       fmt.Sprintf("%s - Comments", h.config.Title),
+		Link:        &feeds.Link{Href: h.baseURL},
+		Description: h.config.Description,
+		Author:      &feeds.Author{Name: h.config.AuthorName, Email: h.config.AuthorEmail},
+	}
+
+	subjectID := ""
+	post := blog.RenderedPost{}
+
+	if r.URL.Path != "/comments.atom" {
+		subjectID = path.Base(path.Dir(r.URL.Path))
+
+		var err error
+
+		post, err = h.viewPost.Run(subjectID)
+		if err != nil {
+			h.handleViewPostError(w, r, err)
+			return
+		}
+
+		feed.Title = fmt.Sprintf("%s - Comments on %s", h.config.Title, post.Post.Title)
+		feed.Link = &feeds.Link{Href: h.postURL(subjectID)}
+	}
+
+	comments, err := h.listRecentComments.Run(r.Context(), subjectID, recentCommentsLimit)
+	if err != nil {
+		h.renderServerError(w, r)
+		return
+	}
+
+	validators := lib.NewValidators(latestCommentChange(time.Time{}, comments), h.baseURL, fmt.Sprint(h.config), r.URL.Path, post.Post.Title, commentVersions(comments))
+
+	if lib.NotModified(w, r, lib.CacheFeed, validators) {
+		return
+	}
+
+	feed.Created = latestCommentChange(defaultFeedUpdatedTime, comments)
+	feed.Items = h.buildFeedItems(comments)
+
+	h.renderFeed(w, feed)
+}
+
+func (h *CommentsFeedHandler) handleViewPostError(w http.ResponseWriter, r *http.Request, err error) {
+	if err == blog.ErrPostNotFound {
+		h.renderNotFound(w, r)
+	} else {
+		h.renderServerError(w, r)
+	}
+}
+
+func (h *CommentsFeedHandler) renderNotFound(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotFound)
+	h.template.Render(w, r, "404.html", nil)
+}
+
+func (h *CommentsFeedHandler) renderServerError(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusInternalServerError)
+	h.template.Render(w, r, "500.html", nil)
+}
+
+func (h *CommentsFeedHandler) renderFeed(w http.ResponseWriter, feed *feeds.Feed) {
+	atom := (&feeds.Atom{Feed: feed}).AtomFeed()
+
+	// The id is taken from the link by default, which is the same as the one of
+	// the posts feed, and the comments have nothing to summarize.
+	atom.Id = feed.Id
+
+	for _, entry := range atom.Entries {
+		entry.Summary = nil
+	}
+
+	w.Header().Add("Content-Type", "application/atom+xml")
+	w.WriteHeader(http.StatusOK)
+
+	if err := feeds.WriteXML(newAtomFeed(atom, feed.Id, nil), w); err != nil {
+		panic(fmt.Sprintf("Something went wrong rendering the comments feed: %v", err))
+	}
+}
+
+func (h *CommentsFeedHandler) postURL(postPath string) string {
+	return fmt.Sprintf("%s/posts/%s", h.baseURL, postPath)
+}
+
+func (h *CommentsFeedHandler) buildFeedItems(comments []*discussion.Comment) []*feeds.Item {
+	items := []*feeds.Item{}
+
+	for _, comment := range comments {
+		items = append(items, h.buildFeedItem(comment))
+	}
+
+	return items
+}
+
+func (h *CommentsFeedHandler) buildFeedItem(comment *discussion.Comment) *feeds.Item {
+	authorName := ""
+
+	if comment.Author != nil {
+		authorName = comment.Author.Name
+	}
+
+	link := fmt.Sprintf("%s#comment-%s", h.postURL(comment.RootSubjectID), comment.ID)
+
+	// The id would otherwise be derived from the link without its fragment,
+	// making it the same for every comment of the post.
+	return &feeds.Item{
+		Id:      link,
+		Title:   fmt.Sprintf("Comment by %s", authorName),
+		Link:    &feeds.Link{Href: link},
+		Author:  &feeds.Author{Name: authorName},
+		Content: comment.HTML,
+		Created: comment.CreatedAt,
+		Updated: comment.UpdatedAt,
+	}
+}
@@ -0,0 +1,184 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/geisonbiazus/blog/internal/core/blog"
+	"github.com/geisonbiazus/blog/internal/core/discussion"
+	. "github.com/geisonbiazus/blog/internal/core/discussion/test"
+	"github.com/geisonbiazus/blog/internal/ui/web/handlers"
+	"github.com/geisonbiazus/blog/internal/ui/web/test"
+	"github.com/geisonbiazus/blog/pkg/testhelper"
+	"github.com/stretchr/testify/assert"
+)
+
+type commentsFeedHandlerFixture struct {
+	listRecentComments *listRecentCommentsUseCaseSpy
+	viewPost           *viewPostUseCaseSpy
+	handler            http.Handler
+}
+
+func TestCommentsFeedHandler(t *testing.T) {
+	setup := func() *commentsFeedHandlerFixture {
+		listRecentComments := &listRecentCommentsUseCaseSpy{}
+		viewPost := &viewPostUseCaseSpy{}
+		templateRenderer := test.NewTestTemplateRenderer()
+		handler := handlers.NewCommentsFeedHandler(listRecentComments, viewPost, templateRenderer, "http://example.com", feedConfig)
+
+		return &commentsFeedHandlerFixture{
+			listRecentComments: listRecentComments,
+			viewPost:           viewPost,
+			handler:            handler,
+		}
+	}
+
+	createdAt := time.Date(2022, time.October, 4, 9, 0, 0, 0, time.UTC)
+	author := NewAuthor(discussion.Author{Name: "Comment Author"})
+	comment := NewComment(discussion.Comment{
+		ID:            "COMMENT_ID",
+		Author:        author,
+		HTML:          "<p>Comment</p>",
+		CreatedAt:     createdAt,
+		UpdatedAt:     createdAt.Add(time.Hour),
+		RootSubjectID: "test-post-1",
+	})
+	reply := NewComment(discussion.Comment{
+		ID:            "REPLY_ID",
+		SubjectID:     "COMMENT_ID",
+		Author:        author,
+		HTML:          "<p>Reply</p>",
+		CreatedAt:     createdAt.Add(30 * time.Minute),
+		RootSubjectID: "test-post-1",
+	})
+
+	t.Run("It returns the feed of the recent comments of the blog", func(t *testing.T) {
+		f := setup()
+
+		f.listRecentComments.ReturnComments = []*discussion.Comment{reply, comment}
+
+		res := test.DoGetRequest(f.handler, "/comments.atom")
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, "application/atom+xml", res.Header.Get("Content-Type"))
+		assert.Equal(t, "", f.listRecentComments.ReceivedSubjectID)
+		assert.Equal(t, 20, f.listRecentComments.ReceivedLimit)
+		assertFeedEqual(t, expectedCommentsFeed, body)
+	})
+
+	t.Run("It returns the feed of the recent comments of a post", func(t *testing.T) {
+		f := setup()
+
+		f.viewPost.ReturnPost = renderedPost1
+		f.listRecentComments.ReturnComments = []*discussion.Comment{comment}
+
+		res := test.DoGetRequest(f.handler, "/posts/test-post-1/comments.atom")
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, "test-post-1", f.viewPost.ReceivedPath)
+		assert.Equal(t, "test-post-1", f.listRecentComments.ReceivedSubjectID)
+		assert.Contains(t, body, "<title>Geison Biazus - Comments on Test Post 1</title>")
+		assert.Contains(t, body, `<link href="http://example.com/posts/test-post-1"></link>`)
+		assert.Contains(t, body, `<link href="http://example.com/posts/test-post-1/comments.atom" rel="self"></link>`)
+		assert.Contains(t, body, `<link href="http://example.com/posts/test-post-1#comment-COMMENT_ID" rel="alternate"></link>`)
+	})
+
+	t.Run("Given no comments it returns the empty feed", func(t *testing.T) {
+		f := setup()
+
+		res := test.DoGetRequest(f.handler, "/comments.atom")
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Contains(t, body, "<updated>2021-04-01T12:00:00Z</updated>")
+		assert.NotContains(t, body, "<entry>")
+	})
+
+	t.Run("Given the client has the current version it responds with not modified", func(t *testing.T) {
+		f := setup()
+
+		f.listRecentComments.ReturnComments = []*discussion.Comment{reply, comment}
+
+		res := test.DoGetRequest(f.handler, "/comments.atom")
+
+		assert.Equal(t, "Tue, 04 Oct 2022 10:00:00 GMT", res.Header.Get("Last-Modified"))
+		assert.Equal(t, "public, max-age=300", res.Header.Get("Cache-Control"))
+
+		res = test.DoConditionalGetRequest(f.handler, "/comments.atom", "If-None-Match", res.Header.Get("ETag"))
+
+		assert.Equal(t, http.StatusNotModified, res.StatusCode)
+	})
+
+	t.Run("Given the post doesn't exist it returns 404", func(t *testing.T) {
+		f := setup()
+
+		f.viewPost.ReturnError = blog.ErrPostNotFound
+
+		res := test.DoGetRequest(f.handler, "/posts/unknown/comments.atom")
+
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+	})
+
+	t.Run("Given an error occurs on getting the comments it returns 500", func(t *testing.T) {
+		f := setup()
+
+		f.listRecentComments.ReturnError = errors.New("any error")
+
+		res := test.DoGetRequest(f.handler, "/comments.atom")
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+		assert.Contains(t, body, "Internal server error")
+	})
+}
+
+var expectedCommentsFeed = `<?xml version="1.0" encoding="UTF-8"?><feed xmlns="http://www.w3.org/2005/Atom">
+	<title>Geison Biazus - Comments</title>
+	<id>http://example.com/comments.atom</id>
+	<updated>2022-10-04T10:00:00Z</updated>
+	<subtitle>My personal blog about software development.</subtitle>
+	<author>
+		<name>Geison Biazus</name>
+		<email>geisonbiazus@gmail.com</email>
+	</author>
+	<link href="http://example.com"></link>
+	<link href="http://example.com/comments.atom" rel="self"></link>
+	<entry>
+		<title>Comment by Comment Author</title>
+		<updated>2022-10-04T09:30:00Z</updated>
+		<id>http://example.com/posts/test-post-1#comment-REPLY_ID</id>
+		<content type="html">&lt;p&gt;Reply&lt;/p&gt;</content>
+		<link href="http://example.com/posts/test-post-1#comment-REPLY_ID" rel="alternate"></link>
+		<author>
+			<name>Comment Author</name>
+		</author>
+	</entry>
+	<entry>
+		<title>Comment by Comment Author</title>
+		<updated>2022-10-04T10:00:00Z</updated>
+		<id>http://example.com/posts/test-post-1#comment-COMMENT_ID</id>
+		<content type="html">&lt;p&gt;Comment&lt;/p&gt;</content>
+		<link href="http://example.com/posts/test-post-1#comment-COMMENT_ID" rel="alternate"></link>
+		<author>
+			<name>Comment Author</name>
+		</author>
+	</entry>
+</feed>`
+
+type listRecentCommentsUseCaseSpy struct {
+	ReceivedSubjectID string
+	ReceivedLimit     int
+	ReturnComments    []*discussion.Comment
+	ReturnError       error
+}
+
+func (u *listRecentCommentsUseCaseSpy) Run(ctx context.Context, subjectID string, limit int) ([]*discussion.Comment, error) {
+	u.ReceivedSubjectID = subjectID
+	u.ReceivedLimit = limit
+	return u.ReturnComments, u.ReturnError
+}
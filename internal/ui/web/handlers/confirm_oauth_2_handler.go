@@ -34,28 +34,20 @@ func (h *ConfirmOAuth2Handler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	token, err := h.usecase.Run(r.Context(), state, code)
 
 	if err != nil {
-		h.respondWithError(w, err)
+		h.respondWithError(w, r, err)
 		return
 	}
 
-	http.SetCookie(w, h.newSessionCookie(token))
+	http.SetCookie(w, lib.NewSessionCookie(token))
 	http.Redirect(w, r, h.baseURL, http.StatusSeeOther)
 }
 
-func (h *ConfirmOAuth2Handler) respondWithError(w http.ResponseWriter, err error) {
+func (h *ConfirmOAuth2Handler) respondWithError(w http.ResponseWriter, r *http.Request, err error) {
 	if errors.Is(err, auth.ErrInvalidState) {
 		w.WriteHeader(http.StatusNotFound)
-		h.template.Render(w, "404.html", nil)
+		h.template.Render(w, r, "404.html", nil)
 	} else {
 		w.WriteHeader(http.StatusInternalServerError)
-		h.template.Render(w, "500.html", nil)
-	}
-}
-
-func (h *ConfirmOAuth2Handler) newSessionCookie(token string) *http.Cookie {
-	return &http.Cookie{
-		Name:  "_blog_session",
-		Value: token,
-		Path:  "/",
+		h.template.Render(w, r, "500.html", nil)
 	}
 }
@@ -75,7 +75,7 @@ func TestConfirmOAuth2Handler(t *testing.T) {
 
 		assert.Equal(t, http.StatusSeeOther, res.StatusCode)
 		assert.Equal(t, baseURL, res.Header.Get("Location"))
-		assert.Equal(t, "_blog_session=token; Path=/", res.Cookies()[0].String())
+		assert.Equal(t, "_blog_session=token; Path=/; HttpOnly; SameSite=Lax", res.Cookies()[0].String())
 	})
 }
 
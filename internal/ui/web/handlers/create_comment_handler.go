@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/geisonbiazus/blog/internal/core/auth"
+	"github.com/geisonbiazus/blog/internal/core/discussion"
+	"github.com/geisonbiazus/blog/internal/ui/web/lib"
+	"github.com/geisonbiazus/blog/internal/ui/web/ports"
+)
+
+type CreateCommentHandler struct {
+	createCommentUseCase ports.CreateCommentUseCase
+	template             *lib.TemplateRenderer
+}
+
+func NewCreateCommentHandler(
+	createCommentUseCase ports.CreateCommentUseCase,
+	templateRenderer *lib.TemplateRenderer,
+) *CreateCommentHandler {
+	return &CreateCommentHandler{
+		createCommentUseCase: createCommentUseCase,
+		template:             templateRenderer,
+	}
+}
+
+func (h *CreateCommentHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	user, ok := lib.CurrentUser(r.Context())
+	if !ok {
+		http.Redirect(w, r, "/login/github", http.StatusSeeOther)
+		return
+	}
+
+	if !user.Can(auth.PermissionComment) {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	postPath := commentPostPath(r)
+
+	comment, err := h.createCommentUseCase.Run(r.Context(), h.inputFrom(r, user.ID, postPath))
+	if err != nil {
+		h.handleError(w, r, err, postPath)
+		return
+	}
+
+	http.Redirect(w, r, fmt.Sprintf("%s#comment-%s", postPath, comment.ID), http.StatusSeeOther)
+}
+
+// commentPostPath returns the path of the post a comment form was submitted from.
+func commentPostPath(r *http.Request) string {
+	return fmt.Sprintf("/posts/%s", path.Base(r.PostFormValue("post_path")))
+}
+
+func (h *CreateCommentHandler) inputFrom(r *http.Request, userID, postPath string) discussion.CreateCommentInput {
+	subjectID := strings.TrimSpace(r.PostFormValue("subject_id"))
+
+	if subjectID == "" {
+		subjectID = path.Base(postPath)
+	}
+
+	return discussion.CreateCommentInput{
+		UserID:    userID,
+		SubjectID: subjectID,
+		Markdown:  r.PostFormValue("markdown"),
+	}
+}
+
+func (h *CreateCommentHandler) handleError(w http.ResponseWriter, r *http.Request, err error, postPath string) {
+	if errors.Is(err, discussion.ErrEmptyComment) {
+		http.Redirect(w, r, postPath, http.StatusSeeOther)
+		return
+	}
+
+	if errors.Is(err, discussion.ErrAuthorBanned) {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	if errors.Is(err, discussion.ErrSubjectNotFound) {
+		w.WriteHeader(http.StatusNotFound)
+		h.template.Render(w, r, "404.html", nil)
+		return
+	}
+
+	w.WriteHeader(http.StatusInternalServerError)
+	h.template.Render(w, r, "500.html", nil)
+}
@@ -0,0 +1,156 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/geisonbiazus/blog/internal/core/auth"
+	"github.com/geisonbiazus/blog/internal/core/discussion"
+	"github.com/geisonbiazus/blog/internal/ui/web/handlers"
+	"github.com/geisonbiazus/blog/internal/ui/web/lib"
+	"github.com/geisonbiazus/blog/internal/ui/web/test"
+	"github.com/geisonbiazus/blog/pkg/testhelper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateCommentHandler(t *testing.T) {
+	type fixture struct {
+		handler              *handlers.CreateCommentHandler
+		createCommentUseCase *createCommentUseCaseSpy
+	}
+
+	setup := func() fixture {
+		createCommentUseCase := &createCommentUseCaseSpy{}
+		templateRenderer := test.NewTestTemplateRenderer()
+		handler := handlers.NewCreateCommentHandler(createCommentUseCase, templateRenderer)
+
+		return fixture{
+			handler:              handler,
+			createCommentUseCase: createCommentUseCase,
+		}
+	}
+
+	form := url.Values{
+		"post_path":  {"/posts/post-path"},
+		"subject_id": {"COMMENT_ID"},
+		"markdown":   {"Comment markdown"},
+	}
+
+	newRequest := func(form url.Values) *http.Request {
+		req := test.NewPostFormRequest("/comments", form)
+		return req.WithContext(lib.WithCurrentUser(req.Context(), auth.User{ID: "USER_ID", Role: auth.RoleCommenter}))
+	}
+
+	t.Run("It creates the comment and redirects to it", func(t *testing.T) {
+		f := setup()
+		f.createCommentUseCase.ReturnComment = &discussion.Comment{ID: "NEW_COMMENT_ID"}
+
+		res := test.DoRequest(f.handler, newRequest(form))
+
+		assert.Equal(t, discussion.CreateCommentInput{
+			UserID:    "USER_ID",
+			SubjectID: "COMMENT_ID",
+			Markdown:  "Comment markdown",
+		}, f.createCommentUseCase.ReceivedInput)
+		assert.Equal(t, http.StatusSeeOther, res.StatusCode)
+		assert.Equal(t, "/posts/post-path#comment-NEW_COMMENT_ID", res.Header.Get("Location"))
+	})
+
+	t.Run("It uses the post path as subject when no subject is given", func(t *testing.T) {
+		f := setup()
+		f.createCommentUseCase.ReturnComment = &discussion.Comment{ID: "NEW_COMMENT_ID"}
+
+		test.DoRequest(f.handler, newRequest(url.Values{
+			"post_path": {"/posts/post-path"},
+			"markdown":  {"Comment markdown"},
+		}))
+
+		assert.Equal(t, "post-path", f.createCommentUseCase.ReceivedInput.SubjectID)
+	})
+
+	t.Run("It redirects to login when there is no session", func(t *testing.T) {
+		f := setup()
+
+		res := test.DoRequest(f.handler, test.NewPostFormRequest("/comments", form))
+
+		assert.Equal(t, http.StatusSeeOther, res.StatusCode)
+		assert.Equal(t, "/login/github", res.Header.Get("Location"))
+		assert.False(t, f.createCommentUseCase.Called)
+	})
+
+	t.Run("It redirects back to the post when the comment is empty", func(t *testing.T) {
+		f := setup()
+		f.createCommentUseCase.ReturnError = discussion.ErrEmptyComment
+
+		res := test.DoRequest(f.handler, newRequest(form))
+
+		assert.Equal(t, http.StatusSeeOther, res.StatusCode)
+		assert.Equal(t, "/posts/post-path", res.Header.Get("Location"))
+	})
+
+	t.Run("It responds with 403 when the current user is banned", func(t *testing.T) {
+		f := setup()
+
+		req := test.NewPostFormRequest("/comments", form)
+		req = req.WithContext(lib.WithCurrentUser(req.Context(), auth.User{ID: "USER_ID", Role: auth.RoleBanned}))
+		res := test.DoRequest(f.handler, req)
+
+		assert.Equal(t, http.StatusForbidden, res.StatusCode)
+		assert.False(t, f.createCommentUseCase.Called)
+	})
+
+	t.Run("It responds with 403 when the author is banned", func(t *testing.T) {
+		f := setup()
+		f.createCommentUseCase.ReturnError = discussion.ErrAuthorBanned
+
+		res := test.DoRequest(f.handler, newRequest(form))
+
+		assert.Equal(t, http.StatusForbidden, res.StatusCode)
+	})
+
+	t.Run("It responds with 404 when the subject is not open for comments", func(t *testing.T) {
+		f := setup()
+		f.createCommentUseCase.ReturnError = discussion.ErrSubjectNotFound
+
+		res := test.DoRequest(f.handler, newRequest(form))
+
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+	})
+
+	t.Run("It responds with 500 when an unrecognized error is returned", func(t *testing.T) {
+		f := setup()
+		f.createCommentUseCase.ReturnError = errors.New("some error")
+
+		res := test.DoRequest(f.handler, newRequest(form))
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+		assert.Contains(t, body, "Internal server error")
+	})
+
+	t.Run("It responds with 405 when the method is not POST", func(t *testing.T) {
+		f := setup()
+
+		res := test.DoGetRequest(f.handler, "/comments")
+
+		assert.Equal(t, http.StatusMethodNotAllowed, res.StatusCode)
+	})
+}
+
+type createCommentUseCaseSpy struct {
+	Called          bool
+	ReceivedContext context.Context
+	ReceivedInput   discussion.CreateCommentInput
+	ReturnComment   *discussion.Comment
+	ReturnError     error
+}
+
+func (u *createCommentUseCaseSpy) Run(ctx context.Context, input discussion.CreateCommentInput) (*discussion.Comment, error) {
+	u.Called = true
+	u.ReceivedContext = ctx
+	u.ReceivedInput = input
+	return u.ReturnComment, u.ReturnError
+}
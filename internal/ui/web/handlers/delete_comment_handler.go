@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/geisonbiazus/blog/internal/core/discussion"
+	"github.com/geisonbiazus/blog/internal/ui/web/lib"
+	"github.com/geisonbiazus/blog/internal/ui/web/ports"
+)
+
+type DeleteCommentHandler struct {
+	usecase  ports.DeleteCommentUseCase
+	template *lib.TemplateRenderer
+}
+
+func NewDeleteCommentHandler(usecase ports.DeleteCommentUseCase, templateRenderer *lib.TemplateRenderer) *DeleteCommentHandler {
+	return &DeleteCommentHandler{
+		usecase:  usecase,
+		template: templateRenderer,
+	}
+}
+
+func (h *DeleteCommentHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	user, ok := lib.CurrentUser(r.Context())
+	if !ok {
+		http.Redirect(w, r, "/login/github", http.StatusSeeOther)
+		return
+	}
+
+	err := h.usecase.Run(r.Context(), user.ID, r.PostFormValue("comment_id"))
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	http.Redirect(w, r, commentPostPath(r), http.StatusSeeOther)
+}
+
+func (h *DeleteCommentHandler) handleError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, discussion.ErrCommentNotFound) || errors.Is(err, discussion.ErrNotCommentOwner) {
+		w.WriteHeader(http.StatusNotFound)
+		h.template.Render(w, r, "404.html", nil)
+		return
+	}
+
+	if errors.Is(err, discussion.ErrAuthorBanned) {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	w.WriteHeader(http.StatusInternalServerError)
+	h.template.Render(w, r, "500.html", nil)
+}
@@ -0,0 +1,95 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/geisonbiazus/blog/internal/core/discussion"
+	"github.com/geisonbiazus/blog/internal/ui/web/handlers"
+	"github.com/geisonbiazus/blog/internal/ui/web/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeleteCommentHandler(t *testing.T) {
+	type fixture struct {
+		handler *handlers.DeleteCommentHandler
+		usecase *deleteCommentUseCaseSpy
+	}
+
+	setup := func() fixture {
+		usecase := &deleteCommentUseCaseSpy{}
+		handler := handlers.NewDeleteCommentHandler(usecase, test.NewTestTemplateRenderer())
+
+		return fixture{handler: handler, usecase: usecase}
+	}
+
+	form := url.Values{
+		"post_path":  {"/posts/post-path"},
+		"comment_id": {"COMMENT_ID"},
+	}
+
+	t.Run("It deletes the comment and redirects to the post", func(t *testing.T) {
+		f := setup()
+
+		res := test.DoRequest(f.handler, newAuthenticatedFormRequest("/comments/delete", form))
+
+		assert.Equal(t, "USER_ID", f.usecase.ReceivedUserID)
+		assert.Equal(t, "COMMENT_ID", f.usecase.ReceivedCommentID)
+		assert.Equal(t, http.StatusSeeOther, res.StatusCode)
+		assert.Equal(t, "/posts/post-path", res.Header.Get("Location"))
+	})
+
+	t.Run("It redirects to login when there is no session", func(t *testing.T) {
+		f := setup()
+
+		res := test.DoRequest(f.handler, test.NewPostFormRequest("/comments/delete", form))
+
+		assert.Equal(t, http.StatusSeeOther, res.StatusCode)
+		assert.Equal(t, "/login/github", res.Header.Get("Location"))
+		assert.Equal(t, "", f.usecase.ReceivedCommentID)
+	})
+
+	t.Run("It responds with 404 when the comment doesn't exist", func(t *testing.T) {
+		f := setup()
+		f.usecase.ReturnError = discussion.ErrCommentNotFound
+
+		res := test.DoRequest(f.handler, newAuthenticatedFormRequest("/comments/delete", form))
+
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+	})
+
+	t.Run("It responds with 403 when the author is banned", func(t *testing.T) {
+		f := setup()
+		f.usecase.ReturnError = discussion.ErrAuthorBanned
+
+		res := test.DoRequest(f.handler, newAuthenticatedFormRequest("/comments/delete", form))
+
+		assert.Equal(t, http.StatusForbidden, res.StatusCode)
+	})
+
+	t.Run("It responds with 500 when an unrecognized error is returned", func(t *testing.T) {
+		f := setup()
+		f.usecase.ReturnError = errors.New("some error")
+
+		res := test.DoRequest(f.handler, newAuthenticatedFormRequest("/comments/delete", form))
+
+		assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+	})
+}
+
+type deleteCommentUseCaseSpy struct {
+	ReceivedContext   context.Context
+	ReceivedUserID    string
+	ReceivedCommentID string
+	ReturnError       error
+}
+
+func (u *deleteCommentUseCaseSpy) Run(ctx context.Context, userID, commentID string) error {
+	u.ReceivedContext = ctx
+	u.ReceivedUserID = userID
+	u.ReceivedCommentID = commentID
+	return u.ReturnError
+}
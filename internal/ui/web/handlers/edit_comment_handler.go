@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/geisonbiazus/blog/internal/core/discussion"
+	"github.com/geisonbiazus/blog/internal/ui/web/lib"
+	"github.com/geisonbiazus/blog/internal/ui/web/ports"
+)
+
+type EditCommentHandler struct {
+	usecase  ports.EditCommentUseCase
+	template *lib.TemplateRenderer
+}
+
+func NewEditCommentHandler(usecase ports.EditCommentUseCase, templateRenderer *lib.TemplateRenderer) *EditCommentHandler {
+	return &EditCommentHandler{
+		usecase:  usecase,
+		template: templateRenderer,
+	}
+}
+
+func (h *EditCommentHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	user, ok := lib.CurrentUser(r.Context())
+	if !ok {
+		http.Redirect(w, r, "/login/github", http.StatusSeeOther)
+		return
+	}
+
+	postPath := commentPostPath(r)
+	commentID := r.PostFormValue("comment_id")
+	commentURL := fmt.Sprintf("%s#comment-%s", postPath, commentID)
+
+	_, err := h.usecase.Run(r.Context(), discussion.EditCommentInput{
+		UserID:    user.ID,
+		CommentID: commentID,
+		Markdown:  r.PostFormValue("markdown"),
+	})
+	if err != nil {
+		h.handleError(w, r, err, commentURL)
+		return
+	}
+
+	http.Redirect(w, r, commentURL, http.StatusSeeOther)
+}
+
+func (h *EditCommentHandler) handleError(w http.ResponseWriter, r *http.Request, err error, commentURL string) {
+	switch {
+	case errors.Is(err, discussion.ErrEmptyComment):
+		http.Redirect(w, r, commentURL, http.StatusSeeOther)
+	case errors.Is(err, discussion.ErrCommentNotFound), errors.Is(err, discussion.ErrNotCommentOwner):
+		w.WriteHeader(http.StatusNotFound)
+		h.template.Render(w, r, "404.html", nil)
+	case errors.Is(err, discussion.ErrAuthorBanned):
+		w.WriteHeader(http.StatusForbidden)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		h.template.Render(w, r, "500.html", nil)
+	}
+}
@@ -0,0 +1,115 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/geisonbiazus/blog/internal/core/auth"
+	"github.com/geisonbiazus/blog/internal/core/discussion"
+	"github.com/geisonbiazus/blog/internal/ui/web/handlers"
+	"github.com/geisonbiazus/blog/internal/ui/web/lib"
+	"github.com/geisonbiazus/blog/internal/ui/web/test"
+	"github.com/geisonbiazus/blog/pkg/testhelper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEditCommentHandler(t *testing.T) {
+	type fixture struct {
+		handler *handlers.EditCommentHandler
+		usecase *editCommentUseCaseSpy
+	}
+
+	setup := func() fixture {
+		usecase := &editCommentUseCaseSpy{}
+		handler := handlers.NewEditCommentHandler(usecase, test.NewTestTemplateRenderer())
+
+		return fixture{handler: handler, usecase: usecase}
+	}
+
+	form := url.Values{
+		"post_path":  {"/posts/post-path"},
+		"comment_id": {"COMMENT_ID"},
+		"markdown":   {"Edited markdown"},
+	}
+
+	t.Run("It edits the comment and redirects to it", func(t *testing.T) {
+		f := setup()
+
+		res := test.DoRequest(f.handler, newAuthenticatedFormRequest("/comments/edit", form))
+
+		assert.Equal(t, discussion.EditCommentInput{
+			UserID:    "USER_ID",
+			CommentID: "COMMENT_ID",
+			Markdown:  "Edited markdown",
+		}, f.usecase.ReceivedInput)
+		assert.Equal(t, http.StatusSeeOther, res.StatusCode)
+		assert.Equal(t, "/posts/post-path#comment-COMMENT_ID", res.Header.Get("Location"))
+	})
+
+	t.Run("It redirects to login when there is no session", func(t *testing.T) {
+		f := setup()
+
+		res := test.DoRequest(f.handler, test.NewPostFormRequest("/comments/edit", form))
+
+		assert.Equal(t, http.StatusSeeOther, res.StatusCode)
+		assert.Equal(t, "/login/github", res.Header.Get("Location"))
+	})
+
+	t.Run("It responds with 404 when the user doesn't own the comment", func(t *testing.T) {
+		f := setup()
+		f.usecase.ReturnError = discussion.ErrNotCommentOwner
+
+		res := test.DoRequest(f.handler, newAuthenticatedFormRequest("/comments/edit", form))
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+		assert.Contains(t, body, "Page not found")
+	})
+
+	t.Run("It responds with 403 when the author is banned", func(t *testing.T) {
+		f := setup()
+		f.usecase.ReturnError = discussion.ErrAuthorBanned
+
+		res := test.DoRequest(f.handler, newAuthenticatedFormRequest("/comments/edit", form))
+
+		assert.Equal(t, http.StatusForbidden, res.StatusCode)
+	})
+
+	t.Run("It responds with 500 when an unrecognized error is returned", func(t *testing.T) {
+		f := setup()
+		f.usecase.ReturnError = errors.New("some error")
+
+		res := test.DoRequest(f.handler, newAuthenticatedFormRequest("/comments/edit", form))
+
+		assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+	})
+
+	t.Run("It responds with 405 when the method is not POST", func(t *testing.T) {
+		f := setup()
+
+		res := test.DoGetRequest(f.handler, "/comments/edit")
+
+		assert.Equal(t, http.StatusMethodNotAllowed, res.StatusCode)
+	})
+}
+
+func newAuthenticatedFormRequest(path string, form url.Values) *http.Request {
+	req := test.NewPostFormRequest(path, form)
+	return req.WithContext(lib.WithCurrentUser(req.Context(), auth.User{ID: "USER_ID", Role: auth.RoleCommenter}))
+}
+
+type editCommentUseCaseSpy struct {
+	ReceivedContext context.Context
+	ReceivedInput   discussion.EditCommentInput
+	ReturnComment   *discussion.Comment
+	ReturnError     error
+}
+
+func (u *editCommentUseCaseSpy) Run(ctx context.Context, input discussion.EditCommentInput) (*discussion.Comment, error) {
+	u.ReceivedContext = ctx
+	u.ReceivedInput = input
+	return u.ReturnComment, u.ReturnError
+}
@@ -1,8 +1,12 @@
 package handlers
 
 import (
+	"encoding/json"
+	"encoding/xml"
 	"fmt"
 	"net/http"
+	"path"
+	"strings"
 	"time"
 
 	"github.com/geisonbiazus/blog/internal/core/blog"
@@ -11,61 +15,202 @@ import (
 	"github.com/gorilla/feeds"
 )
 
+// FeedConfig describes the blog in the feeds. Unless FullContent is set, the
+// feed items only have the summary of the posts.
+type FeedConfig struct {
+	Title       string
+	Description string
+	AuthorName  string
+	AuthorEmail string
+	FullContent bool
+}
+
+// FeedHandler serves the posts as an Atom, RSS 2.0 or JSON Feed 1.1 feed
+// depending on the extension of the requested path. Feeds can be scoped to a
+// tag, a series or an author, e.g. /tags/go/feed.atom.
 type FeedHandler struct {
-	usecase  ports.ListPostUseCase
+	usecase  ports.ListRenderedPostsUseCase
 	template *lib.TemplateRenderer
 	baseURL  string
+	config   FeedConfig
 }
 
-func NewFeedHandler(usecase ports.ListPostUseCase, templateRenderer *lib.TemplateRenderer, baseURL string) *FeedHandler {
+func NewFeedHandler(
+	usecase ports.ListRenderedPostsUseCase,
+	templateRenderer *lib.TemplateRenderer,
+	baseURL string,
+	config FeedConfig,
+) *FeedHandler {
 	return &FeedHandler{
 		usecase:  usecase,
 		template: templateRenderer,
 		baseURL:  baseURL,
+		config:   config,
 	}
 }
 
+type feedWriter func(w http.ResponseWriter, feed *feeds.Feed, posts []blog.RenderedPost) error
+
+var feedWriters = map[string]feedWriter{
+	".atom": writeAtomFeed,
+	".rss":  writeRSSFeed,
+	".json": writeJSONFeed,
+}
+
+// IsFeedPath tells whether the path points to a feed, scoped or not.
+func IsFeedPath(feedPath string) bool {
+	_, ok := feedWriters[path.Ext(feedPath)]
+	return ok && strings.TrimSuffix(path.Base(feedPath), path.Ext(feedPath)) == "feed"
+}
+
 func (h *FeedHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	scope, ok := parseFeedScope(r.URL.Path)
+
+	if !ok || !IsFeedPath(r.URL.Path) {
+		h.renderNotFound(w, r)
+		return
+	}
+
 	posts, err := h.usecase.Run()
 
 	if err != nil {
-		h.renderServerError(w)
-	} else {
-		h.renderFeed(w, posts)
+		h.renderServerError(w, r)
+		return
+	}
+
+	posts = scope.filter(posts)
+
+	if len(posts) == 0 && scope.name != nil {
+		h.renderNotFound(w, r)
+		return
+	}
+
+	validators := lib.NewValidators(latestRenderedPostChange(posts), h.baseURL, fmt.Sprint(h.config), r.URL.Path, renderedPostVersions(posts...))
+
+	if lib.NotModified(w, r, lib.CacheFeed, validators) {
+		return
+	}
+
+	writeFeed := feedWriters[path.Ext(r.URL.Path)]
+	h.renderFeed(w, writeFeed, h.buildFeed(r.URL.Path, scope, posts), posts)
+}
+
+func latestRenderedPostChange(posts []blog.RenderedPost) time.Time {
+	lastModified := time.Time{}
+
+	for _, post := range posts {
+		lastModified = latestPostChange(lastModified, post.Post)
 	}
+
+	return lastModified
+}
+
+func (h *FeedHandler) renderNotFound(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotFound)
+	h.template.Render(w, r, "404.html", nil)
 }
 
-func (h *FeedHandler) renderServerError(w http.ResponseWriter) {
+func (h *FeedHandler) renderServerError(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusInternalServerError)
-	h.template.Render(w, "500.html", nil)
+	h.template.Render(w, r, "500.html", nil)
 }
 
-func (h *FeedHandler) renderFeed(w http.ResponseWriter, posts []blog.RenderedPost) {
-	feed := h.buildFeed(posts)
+func (h *FeedHandler) renderFeed(w http.ResponseWriter, writeFeed feedWriter, feed *feeds.Feed, posts []blog.RenderedPost) {
+	if err := writeFeed(w, feed, posts); err != nil {
+		panic(fmt.Sprintf("Something went wrong rendering the feed: %v", err))
+	}
+}
 
-	w.Header().Add("Content-Type", "application/atom+xml")
-	w.WriteHeader(http.StatusOK)
+// feedScope narrows the feed to the posts matching it. The name describes the
+// scope from one of its posts and the page path is where its posts are listed.
+// The feed of the whole blog has no name.
+type feedScope struct {
+	pagePath string
+	match    func(post blog.Post) bool
+	name     func(post blog.Post) string
+}
 
-	if err := feed.WriteAtom(w); err != nil {
-		panic(fmt.Sprintf("Something went wrong rendering the feed: %v", err))
+// parseFeedScope reads the scope from the directory of the feed path. Unknown
+// scopes are not ok.
+func parseFeedScope(feedPath string) (feedScope, bool) {
+	dir := path.Dir(feedPath)
+
+	if dir == "/" {
+		return feedScope{match: func(blog.Post) bool { return true }}, true
+	}
+
+	parts := strings.Split(strings.TrimPrefix(dir, "/"), "/")
+
+	if len(parts) != 2 || parts[1] == "" {
+		return feedScope{}, false
+	}
+
+	value := parts[1]
+
+	switch parts[0] {
+	case "tags":
+		return feedScope{
+			pagePath: tagPath(value),
+			match:    func(post blog.Post) bool { return post.HasTag(value) },
+			name:     func(blog.Post) string { return "#" + value },
+		}, true
+	case "series":
+		return feedScope{
+			pagePath: seriesPath(value),
+			match:    func(post blog.Post) bool { return post.Series != "" && post.SeriesSlug() == value },
+			name:     func(post blog.Post) string { return post.Series },
+		}, true
+	case "authors":
+		return feedScope{
+			pagePath: authorPath(value),
+			match:    func(post blog.Post) bool { return post.AuthorSlug() == value },
+			name:     func(post blog.Post) string { return post.Author },
+		}, true
 	}
+
+	return feedScope{}, false
 }
 
-func (h *FeedHandler) buildFeed(posts []blog.RenderedPost) *feeds.Feed {
+func (s feedScope) filter(posts []blog.RenderedPost) []blog.RenderedPost {
+	result := []blog.RenderedPost{}
+
+	for _, post := range posts {
+		if s.match(post.Post) {
+			result = append(result, post)
+		}
+	}
+
+	return result
+}
+
+func (h *FeedHandler) feedTitle(scope feedScope, posts []blog.RenderedPost) string {
+	if scope.name == nil {
+		return h.config.Title
+	}
+
+	return fmt.Sprintf("%s - %s", h.config.Title, scope.name(posts[0].Post))
+}
+
+// buildFeed keeps the URL of the feed itself in the id, which gorilla/feeds
+// doesn't use for Atom and RSS, so every format can link to it.
+func (h *FeedHandler) buildFeed(feedPath string, scope feedScope, posts []blog.RenderedPost) *feeds.Feed {
 	return &feeds.Feed{
-		Title:       "Geison Biazus",
-		Link:        &feeds.Link{Href: h.baseURL},
-		Description: "My personal blog about software development.",
-		Author:      &feeds.Author{Name: "Geison Biazus", Email: "geisonbiazus@gmail.com"},
+		Id:          h.baseURL + feedPath,
+		Title:       h.feedTitle(scope, posts),
+		Link:        &feeds.Link{Href: h.baseURL + scope.pagePath},
+		Description: h.config.Description,
+		Author:      &feeds.Author{Name: h.config.AuthorName, Email: h.config.AuthorEmail},
 		Created:     h.resolveUpdatedTime(posts),
 		Items:       h.buildFeedItems(posts),
 	}
 }
 
+// defaultFeedUpdatedTime is used by the feeds without entries.
+var defaultFeedUpdatedTime = time.Date(2021, time.April, 1, 12, 0, 0, 0, time.UTC)
+
 func (h *FeedHandler) resolveUpdatedTime(posts []blog.RenderedPost) time.Time {
 	if len(posts) == 0 {
-		defaultTime, _ := time.Parse(time.RFC3339, "2021-04-01T12:00:00Z")
-		return defaultTime
+		return defaultFeedUpdatedTime
 	}
 
 	return posts[0].Post.Time
@@ -82,11 +227,242 @@ func (h *FeedHandler) buildFeedItems(posts []blog.RenderedPost) []*feeds.Item {
 }
 
 func (h *FeedHandler) buildFeedItem(post blog.RenderedPost) *feeds.Item {
-	return &feeds.Item{
-		Title:   post.Post.Title,
-		Link:    &feeds.Link{Href: fmt.Sprintf("%s/posts/%s", h.baseURL, post.Post.Path)},
-		Content: post.HTML,
-		Author:  &feeds.Author{Name: post.Post.Author},
-		Created: post.Post.Time,
+	item := &feeds.Item{
+		Title:       post.Post.Title,
+		Link:        &feeds.Link{Href: fmt.Sprintf("%s/posts/%s", h.baseURL, post.Post.Path)},
+		Description: post.Post.Summary(),
+		Author:      &feeds.Author{Name: post.Post.Author},
+		Created:     post.Post.Time,
+	}
+
+	if h.config.FullContent {
+		item.Content = post.HTML
+	}
+
+	return item
+}
+
+func writeAtomFeed(w http.ResponseWriter, feed *feeds.Feed, posts []blog.RenderedPost) error {
+	atom := (&feeds.Atom{Feed: feed}).AtomFeed()
+
+	w.Header().Add("Content-Type", "application/atom+xml")
+	w.WriteHeader(http.StatusOK)
+
+	return feeds.WriteXML(newAtomFeed(atom, feed.Id, postTags(posts)), w)
+}
+
+func postTags(posts []blog.RenderedPost) [][]string {
+	tags := [][]string{}
+
+	for _, post := range posts {
+		tags = append(tags, post.Post.Tags)
+	}
+
+	return tags
+}
+
+// atomFeed extends the Atom representation of gorilla/feeds, which supports a
+// single plain text category per entry, with a category element per tag and a
+// link to the feed itself.
+type atomFeed struct {
+	*feeds.AtomFeed
+	Links   []*feeds.AtomLink `xml:"link"`
+	Entries []*atomEntry      `xml:"entry"`
+}
+
+type atomEntry struct {
+	*feeds.AtomEntry
+	Categories []atomCategory
+}
+
+type atomCategory struct {
+	XMLName xml.Name `xml:"category"`
+	Term    string   `xml:"term,attr"`
+}
+
+// newAtomFeed adds the tags of each entry as categories. Entries without tags
+// can be left out of them.
+func newAtomFeed(atom *feeds.AtomFeed, selfURL string, tags [][]string) *atomFeed {
+	entries := []*atomEntry{}
+
+	for i, entry := range atom.Entries {
+		categories := []atomCategory{}
+
+		if i < len(tags) {
+			categories = newAtomCategories(tags[i])
+		}
+
+		entries = append(entries, &atomEntry{
+			AtomEntry:  entry,
+			Categories: categories,
+		})
+	}
+
+	return &atomFeed{
+		AtomFeed: atom,
+		Links:    []*feeds.AtomLink{atom.Link, {Href: selfURL, Rel: "self"}},
+		Entries:  entries,
+	}
+}
+
+func newAtomCategories(tags []string) []atomCategory {
+	categories := []atomCategory{}
+
+	for _, tag := range tags {
+		categories = append(categories, atomCategory{Term: tag})
+	}
+
+	return categories
+}
+
+func (f *atomFeed) FeedXml() interface{} {
+	return f
+}
+
+func writeRSSFeed(w http.ResponseWriter, feed *feeds.Feed, posts []blog.RenderedPost) error {
+	rss := (&feeds.Rss{Feed: feed}).RssFeed()
+
+	w.Header().Add("Content-Type", "application/rss+xml")
+	w.WriteHeader(http.StatusOK)
+
+	return feeds.WriteXML(newRSSFeed(rss, feed.Id, posts), w)
+}
+
+// rssFeed extends the RSS representation of gorilla/feeds in the same way as
+// atomFeed, also using the link of the posts as their guid.
+type rssFeed struct {
+	XMLName          xml.Name `xml:"rss"`
+	Version          string   `xml:"version,attr"`
+	ContentNamespace string   `xml:"xmlns:content,attr"`
+	AtomNamespace    string   `xml:"xmlns:atom,attr"`
+	Channel          *rssChannel
+}
+
+type rssChannel struct {
+	*feeds.RssFeed
+	SelfLink rssSelfLink
+	Items    []*rssItem `xml:"item"`
+}
+
+type rssSelfLink struct {
+	XMLName xml.Name `xml:"atom:link"`
+	Href    string   `xml:"href,attr"`
+	Rel     string   `xml:"rel,attr"`
+	Type    string   `xml:"type,attr"`
+}
+
+type rssItem struct {
+	*feeds.RssItem
+	Categories []string `xml:"category"`
+}
+
+func newRSSFeed(rss *feeds.RssFeed, selfURL string, posts []blog.RenderedPost) *rssFeed {
+	items := []*rssItem{}
+
+	for i, item := range rss.Items {
+		item.Guid = item.Link
+		items = append(items, &rssItem{RssItem: item, Categories: posts[i].Post.Tags})
+	}
+
+	return &rssFeed{
+		Version:          "2.0",
+		ContentNamespace: "http://purl.org/rss/1.0/modules/content/",
+		AtomNamespace:    "http://www.w3.org/2005/Atom",
+		Channel: &rssChannel{
+			RssFeed:  rss,
+			SelfLink: rssSelfLink{Href: selfURL, Rel: "self", Type: "application/rss+xml"},
+			Items:    items,
+		},
+	}
+}
+
+func (f *rssFeed) FeedXml() interface{} {
+	return f
+}
+
+const jsonFeedVersion = "https://jsonfeed.org/version/1.1"
+
+func writeJSONFeed(w http.ResponseWriter, feed *feeds.Feed, posts []blog.RenderedPost) error {
+	w.Header().Add("Content-Type", "application/feed+json")
+	w.WriteHeader(http.StatusOK)
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(newJSONFeed(feed, posts))
+}
+
+// jsonFeed follows the JSON Feed 1.1 spec, which gorilla/feeds doesn't
+// support yet.
+type jsonFeed struct {
+	Version     string           `json:"version"`
+	Title       string           `json:"title"`
+	HomePageURL string           `json:"home_page_url"`
+	FeedURL     string           `json:"feed_url"`
+	Description string           `json:"description,omitempty"`
+	Authors     []jsonFeedAuthor `json:"authors,omitempty"`
+	Items       []jsonFeedItem   `json:"items"`
+}
+
+type jsonFeedAuthor struct {
+	Name string `json:"name"`
+}
+
+type jsonFeedItem struct {
+	ID            string           `json:"id"`
+	URL           string           `json:"url"`
+	Title         string           `json:"title"`
+	ContentHTML   string           `json:"content_html,omitempty"`
+	ContentText   string           `json:"content_text,omitempty"`
+	Summary       string           `json:"summary,omitempty"`
+	DatePublished string           `json:"date_published"`
+	Authors       []jsonFeedAuthor `json:"authors,omitempty"`
+	Tags          []string         `json:"tags,omitempty"`
+}
+
+func newJSONFeed(feed *feeds.Feed, posts []blog.RenderedPost) *jsonFeed {
+	items := []jsonFeedItem{}
+
+	for i, item := range feed.Items {
+		items = append(items, newJSONFeedItem(item, posts[i].Post.Tags))
 	}
+
+	return &jsonFeed{
+		Version:     jsonFeedVersion,
+		Title:       feed.Title,
+		HomePageURL: feed.Link.Href,
+		FeedURL:     feed.Id,
+		Description: feed.Description,
+		Authors:     newJSONFeedAuthors(feed.Author),
+		Items:       items,
+	}
+}
+
+// newJSONFeedItem falls back to the summary as text content, since an item
+// must have some content.
+func newJSONFeedItem(item *feeds.Item, tags []string) jsonFeedItem {
+	result := jsonFeedItem{
+		ID:            item.Link.Href,
+		URL:           item.Link.Href,
+		Title:         item.Title,
+		ContentHTML:   item.Content,
+		Summary:       item.Description,
+		DatePublished: item.Created.Format(time.RFC3339),
+		Authors:       newJSONFeedAuthors(item.Author),
+		Tags:          tags,
+	}
+
+	if result.ContentHTML == "" {
+		result.ContentText = item.Description
+	}
+
+	return result
+}
+
+func newJSONFeedAuthors(author *feeds.Author) []jsonFeedAuthor {
+	if author == nil || author.Name == "" {
+		return nil
+	}
+
+	return []jsonFeedAuthor{{Name: author.Name}}
 }
@@ -14,16 +14,16 @@ import (
 )
 
 type feedHandlerFixture struct {
-	usecase *listPostUseCaseSpy
+	usecase *listRenderedPostsUseCaseSpy
 	handler http.Handler
 }
 
 func TestFeedPostsHandler(t *testing.T) {
-	setup := func() *feedHandlerFixture {
-		usecase := &listPostUseCaseSpy{}
+	setupWithConfig := func(config handlers.FeedConfig) *feedHandlerFixture {
+		usecase := &listRenderedPostsUseCaseSpy{}
 		templateRenderer := test.NewTestTemplateRenderer()
 		baseURL := "http://example.com"
-		handler := handlers.NewFeedHandler(usecase, templateRenderer, baseURL)
+		handler := handlers.NewFeedHandler(usecase, templateRenderer, baseURL, config)
 
 		return &feedHandlerFixture{
 			usecase: usecase,
@@ -31,6 +31,10 @@ func TestFeedPostsHandler(t *testing.T) {
 		}
 	}
 
+	setup := func() *feedHandlerFixture {
+		return setupWithConfig(feedConfig)
+	}
+
 	t.Run("Given a list of posts exists it returns the feed containing all posts", func(t *testing.T) {
 		f := setup()
 
@@ -57,6 +61,210 @@ func TestFeedPostsHandler(t *testing.T) {
 		assertFeedEqual(t, expectedEmptyFeed, body)
 	})
 
+	t.Run("It returns the RSS feed", func(t *testing.T) {
+		f := setup()
+
+		f.usecase.ReturnPosts = []blog.RenderedPost{renderedPost2, renderedPost1}
+
+		res := test.DoGetRequest(f.handler, "/feed.rss")
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, "application/rss+xml", res.Header.Get("Content-Type"))
+		assertFeedEqual(t, expectedRSSFeed, body)
+	})
+
+	t.Run("It returns the JSON feed", func(t *testing.T) {
+		f := setup()
+
+		f.usecase.ReturnPosts = []blog.RenderedPost{renderedPost2, renderedPost1}
+
+		res := test.DoGetRequest(f.handler, "/feed.json")
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, "application/feed+json", res.Header.Get("Content-Type"))
+		assert.JSONEq(t, expectedJSONFeed, body)
+	})
+
+	t.Run("Given the summary mode it leaves the content out of the items", func(t *testing.T) {
+		config := feedConfig
+		config.FullContent = false
+		f := setupWithConfig(config)
+
+		f.usecase.ReturnPosts = []blog.RenderedPost{renderedPost1}
+
+		atom := testhelper.ReadResponseBody(test.DoGetRequest(f.handler, "/feed.atom"))
+		rss := testhelper.ReadResponseBody(test.DoGetRequest(f.handler, "/feed.rss"))
+		json := testhelper.ReadResponseBody(test.DoGetRequest(f.handler, "/feed.json"))
+
+		assert.Contains(t, atom, `<summary type="html">Content for post 1</summary>`)
+		assert.NotContains(t, atom, "Rendered content for post 1")
+		assert.Contains(t, rss, "<description>Content for post 1</description>")
+		assert.NotContains(t, rss, "Rendered content for post 1")
+		assert.Contains(t, json, `"content_text": "Content for post 1"`)
+		assert.NotContains(t, json, "content_html")
+	})
+
+	t.Run("It uses the configured feed metadata", func(t *testing.T) {
+		f := setupWithConfig(handlers.FeedConfig{
+			Title:       "Other Blog",
+			Description: "Other description",
+			AuthorName:  "Other Author",
+			AuthorEmail: "other@example.com",
+		})
+
+		f.usecase.ReturnPosts = []blog.RenderedPost{}
+
+		res := test.DoGetRequest(f.handler, "/feed.atom")
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Contains(t, body, "<title>Other Blog</title>")
+		assert.Contains(t, body, "<subtitle>Other description</subtitle>")
+		assert.Contains(t, body, "<name>Other Author</name>")
+		assert.Contains(t, body, "<email>other@example.com</email>")
+	})
+
+	t.Run("Given an unknown feed format it returns 404", func(t *testing.T) {
+		f := setup()
+
+		res := test.DoGetRequest(f.handler, "/feed.xml")
+
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+	})
+
+	t.Run("Given a tag feed it returns only the posts with the tag", func(t *testing.T) {
+		f := setup()
+
+		f.usecase.ReturnPosts = []blog.RenderedPost{renderedPost2, renderedPost1}
+
+		res := test.DoGetRequest(f.handler, "/tags/testing/feed.atom")
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Contains(t, body, "<title>Geison Biazus - #testing</title>")
+		assert.Contains(t, body, "<updated>2021-04-05T18:47:00Z</updated>")
+		assert.Contains(t, body, `<link href="http://example.com/tags/testing"></link>`)
+		assert.Contains(t, body, `<link href="http://example.com/tags/testing/feed.atom" rel="self"></link>`)
+		assert.Contains(t, body, "Test Post 1")
+		assert.NotContains(t, body, "Test Post 2")
+	})
+
+	t.Run("Given a series feed it returns only the posts of the series", func(t *testing.T) {
+		f := setup()
+
+		seriesPost := renderedPost2
+		seriesPost.Post.Series = "Data Structures"
+		f.usecase.ReturnPosts = []blog.RenderedPost{seriesPost, renderedPost1}
+
+		res := test.DoGetRequest(f.handler, "/series/data-structures/feed.rss")
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Contains(t, body, "<title>Geison Biazus - Data Structures</title>")
+		assert.Contains(t, body, "<link>http://example.com/series/data-structures</link>")
+		assert.Contains(t, body, `<atom:link href="http://example.com/series/data-structures/feed.rss" rel="self" type="application/rss+xml"></atom:link>`)
+		assert.Contains(t, body, "Test Post 2")
+		assert.NotContains(t, body, "Test Post 1")
+	})
+
+	t.Run("Given an author feed it returns only the posts of the author", func(t *testing.T) {
+		f := setup()
+
+		otherAuthorPost := renderedPost2
+		otherAuthorPost.Post.Author = "Other Author"
+		f.usecase.ReturnPosts = []blog.RenderedPost{otherAuthorPost, renderedPost1}
+
+		res := test.DoGetRequest(f.handler, "/authors/geison-biazus/feed.json")
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Contains(t, body, `"title": "Geison Biazus - Geison Biazus"`)
+		assert.Contains(t, body, `"home_page_url": "http://example.com/authors/geison-biazus"`)
+		assert.Contains(t, body, `"feed_url": "http://example.com/authors/geison-biazus/feed.json"`)
+		assert.Contains(t, body, "Test Post 1")
+		assert.NotContains(t, body, "Test Post 2")
+	})
+
+	t.Run("Given a scope without posts it returns 404", func(t *testing.T) {
+		f := setup()
+
+		f.usecase.ReturnPosts = []blog.RenderedPost{renderedPost2, renderedPost1}
+
+		res := test.DoGetRequest(f.handler, "/tags/unknown/feed.atom")
+
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+	})
+
+	t.Run("Given an unknown scope it returns 404", func(t *testing.T) {
+		f := setup()
+
+		f.usecase.ReturnPosts = []blog.RenderedPost{renderedPost2, renderedPost1}
+
+		for _, path := range []string{"/posts/test-post-1/feed.atom", "/tags/go/other/feed.atom", "/tags/go/posts.atom"} {
+			res := test.DoGetRequest(f.handler, path)
+
+			assert.Equal(t, http.StatusNotFound, res.StatusCode, path)
+		}
+	})
+
+	t.Run("It sets the validators and lets the feed be cached for a while", func(t *testing.T) {
+		f := setup()
+
+		f.usecase.ReturnPosts = []blog.RenderedPost{renderedPost2, renderedPost1}
+
+		res := test.DoGetRequest(f.handler, "/feed.atom")
+
+		assert.NotEmpty(t, res.Header.Get("ETag"))
+		assert.Equal(t, "Mon, 05 Apr 2021 18:47:00 GMT", res.Header.Get("Last-Modified"))
+		assert.Equal(t, "public, max-age=300", res.Header.Get("Cache-Control"))
+	})
+
+	t.Run("Given the client has the current version it responds with not modified", func(t *testing.T) {
+		f := setup()
+
+		f.usecase.ReturnPosts = []blog.RenderedPost{renderedPost2, renderedPost1}
+
+		header := test.DoGetRequest(f.handler, "/feed.atom").Header
+		byETag := test.DoConditionalGetRequest(f.handler, "/feed.atom", "If-None-Match", header.Get("ETag"))
+		byDate := test.DoConditionalGetRequest(f.handler, "/feed.atom", "If-Modified-Since", header.Get("Last-Modified"))
+
+		assert.Equal(t, http.StatusNotModified, byETag.StatusCode)
+		assert.Empty(t, testhelper.ReadResponseBody(byETag))
+		assert.Equal(t, http.StatusNotModified, byDate.StatusCode)
+	})
+
+	t.Run("Given the posts changed it responds with the feed again", func(t *testing.T) {
+		f := setup()
+
+		f.usecase.ReturnPosts = []blog.RenderedPost{renderedPost1}
+		header := test.DoGetRequest(f.handler, "/feed.atom").Header
+		f.usecase.ReturnPosts = []blog.RenderedPost{renderedPost2, renderedPost1}
+
+		res := test.DoConditionalGetRequest(f.handler, "/feed.atom", "If-None-Match", header.Get("ETag"))
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+
+		res = test.DoConditionalGetRequest(f.handler, "/feed.rss", "If-None-Match", header.Get("ETag"))
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+	})
+
+	t.Run("Given a post was rendered again it responds with the feed again", func(t *testing.T) {
+		f := setup()
+
+		f.usecase.ReturnPosts = []blog.RenderedPost{renderedPost1}
+		etag := test.DoGetRequest(f.handler, "/feed.atom").Header.Get("ETag")
+
+		rerendered := renderedPost1
+		rerendered.Hash = "new-hash"
+		f.usecase.ReturnPosts = []blog.RenderedPost{rerendered}
+
+		res := test.DoConditionalGetRequest(f.handler, "/feed.atom", "If-None-Match", etag)
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+	})
+
 	t.Run("Given an error occurs on getting the posts it returns 500", func(t *testing.T) {
 		f := setup()
 
@@ -81,26 +289,36 @@ func removeWhiteSpaces(source string) string {
 	return reWhiteSpace.ReplaceAllString(source, "")
 }
 
+var feedConfig = handlers.FeedConfig{
+	Title:       "Geison Biazus",
+	Description: "My personal blog about software development.",
+	AuthorName:  "Geison Biazus",
+	AuthorEmail: "geisonbiazus@gmail.com",
+	FullContent: true,
+}
+
 var expectedFeed = `<?xml version="1.0" encoding="UTF-8"?><feed xmlns="http://www.w3.org/2005/Atom">
 	<title>Geison Biazus</title>
 	<id>http://example.com</id>
 	<updated>2021-04-04T14:33:00Z</updated>
 	<subtitle>My personal blog about software development.</subtitle>
-	<link href="http://example.com"></link>
 	<author>
 		<name>Geison Biazus</name>
 		<email>geisonbiazus@gmail.com</email>
 	</author>
+	<link href="http://example.com"></link>
+	<link href="http://example.com/feed.atom" rel="self"></link>
 	<entry>
 		<title>Test Post 2</title>
 		<updated>2021-04-04T14:33:00Z</updated>
 		<id>tag:example.com,2021-04-04:/posts/test-post-2</id>
 		<content type="html">Rendered content for post 2</content>
 		<link href="http://example.com/posts/test-post-2" rel="alternate"></link>
-		<summary type="html"></summary>
+		<summary type="html">Content for post 2</summary>
 		<author>
 			<name>Geison Biazus</name>
 		</author>
+		<category term="go"></category>
 	</entry>
 	<entry>
 		<title>Test Post 1</title>
@@ -108,10 +326,12 @@ var expectedFeed = `<?xml version="1.0" encoding="UTF-8"?><feed xmlns="http://ww
 		<id>tag:example.com,2021-04-05:/posts/test-post-1</id>
 		<content type="html">Rendered content for post 1</content>
 		<link href="http://example.com/posts/test-post-1" rel="alternate"></link>
-		<summary type="html"></summary>
+		<summary type="html">Content for post 1</summary>
 		<author>
 			<name>Geison Biazus</name>
 		</author>
+		<category term="go"></category>
+		<category term="testing"></category>
 	</entry>
 </feed>`
 
@@ -120,9 +340,83 @@ var expectedEmptyFeed = `<?xml version="1.0" encoding="UTF-8"?><feed xmlns="http
 	<id>http://example.com</id>
 	<updated>2021-04-01T12:00:00Z</updated>
 	<subtitle>My personal blog about software development.</subtitle>
-	<link href="http://example.com"></link>
 	<author>
 		<name>Geison Biazus</name>
 		<email>geisonbiazus@gmail.com</email>
 	</author>
+	<link href="http://example.com"></link>
+	<link href="http://example.com/feed.atom" rel="self"></link>
 </feed>`
+
+type listRenderedPostsUseCaseSpy struct {
+	ReturnPosts []blog.RenderedPost
+	ReturnError error
+}
+
+func (u *listRenderedPostsUseCaseSpy) Run() ([]blog.RenderedPost, error) {
+	return u.ReturnPosts, u.ReturnError
+}
+
+var expectedRSSFeed = `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0" xmlns:content="http://purl.org/rss/1.0/modules/content/" xmlns:atom="http://www.w3.org/2005/Atom">
+	<channel>
+		<title>Geison Biazus</title>
+		<link>http://example.com</link>
+		<description>My personal blog about software development.</description>
+		<managingEditor>geisonbiazus@gmail.com (Geison Biazus)</managingEditor>
+		<pubDate>Sun, 04 Apr 2021 14:33:00 +0000</pubDate>
+		<atom:link href="http://example.com/feed.rss" rel="self" type="application/rss+xml"></atom:link>
+		<item>
+			<title>Test Post 2</title>
+			<link>http://example.com/posts/test-post-2</link>
+			<description>Content for post 2</description>
+			<content:encoded><![CDATA[Rendered content for post 2]]></content:encoded>
+			<author>Geison Biazus</author>
+			<guid>http://example.com/posts/test-post-2</guid>
+			<pubDate>Sun, 04 Apr 2021 14:33:00 +0000</pubDate>
+			<category>go</category>
+		</item>
+		<item>
+			<title>Test Post 1</title>
+			<link>http://example.com/posts/test-post-1</link>
+			<description>Content for post 1</description>
+			<content:encoded><![CDATA[Rendered content for post 1]]></content:encoded>
+			<author>Geison Biazus</author>
+			<guid>http://example.com/posts/test-post-1</guid>
+			<pubDate>Mon, 05 Apr 2021 18:47:00 +0000</pubDate>
+			<category>go</category>
+			<category>testing</category>
+		</item>
+	</channel>
+</rss>`
+
+var expectedJSONFeed = `{
+	"version": "https://jsonfeed.org/version/1.1",
+	"title": "Geison Biazus",
+	"home_page_url": "http://example.com",
+	"feed_url": "http://example.com/feed.json",
+	"description": "My personal blog about software development.",
+	"authors": [{"name": "Geison Biazus"}],
+	"items": [
+		{
+			"id": "http://example.com/posts/test-post-2",
+			"url": "http://example.com/posts/test-post-2",
+			"title": "Test Post 2",
+			"content_html": "Rendered content for post 2",
+			"summary": "Content for post 2",
+			"date_published": "2021-04-04T14:33:00Z",
+			"authors": [{"name": "Geison Biazus"}],
+			"tags": ["go"]
+		},
+		{
+			"id": "http://example.com/posts/test-post-1",
+			"url": "http://example.com/posts/test-post-1",
+			"title": "Test Post 1",
+			"content_html": "Rendered content for post 1",
+			"summary": "Content for post 1",
+			"date_published": "2021-04-05T18:47:00Z",
+			"authors": [{"name": "Geison Biazus"}],
+			"tags": ["go", "testing"]
+		}
+	]
+}`
@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+
+	"github.com/geisonbiazus/blog/internal/ui/web/lib"
+	"github.com/geisonbiazus/blog/internal/ui/web/ports"
+)
+
+type ListPostsByAuthorHandler struct {
+	usecase  ports.ListPostsByAuthorUseCase
+	template *lib.TemplateRenderer
+}
+
+func NewListPostsByAuthorHandler(usecase ports.ListPostsByAuthorUseCase, templateRenderer *lib.TemplateRenderer) *ListPostsByAuthorHandler {
+	return &ListPostsByAuthorHandler{
+		usecase:  usecase,
+		template: templateRenderer,
+	}
+}
+
+func (h *ListPostsByAuthorHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	slug := path.Base(r.URL.Path)
+
+	posts, err := h.usecase.Run(slug)
+
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		h.template.Render(w, r, "500.html", nil)
+		return
+	}
+
+	if len(posts) == 0 {
+		w.WriteHeader(http.StatusNotFound)
+		h.template.Render(w, r, "404.html", nil)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	h.template.Render(w, r, "list_posts.html", listPostsViewModel{
+		Heading:  fmt.Sprintf("Posts by %s", posts[0].Author),
+		Posts:    toPostsViewModelList(posts),
+		FeedPath: authorPath(slug) + "/feed.atom",
+	})
+}
+
+func authorPath(slug string) string {
+	return fmt.Sprintf("/authors/%s", url.PathEscape(slug))
+}
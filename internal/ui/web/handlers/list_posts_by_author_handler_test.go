@@ -0,0 +1,65 @@
+package handlers_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/geisonbiazus/blog/internal/core/blog"
+	"github.com/geisonbiazus/blog/internal/ui/web/handlers"
+	"github.com/geisonbiazus/blog/internal/ui/web/test"
+	"github.com/geisonbiazus/blog/pkg/testhelper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListPostsByAuthorHandler(t *testing.T) {
+	setup := func() (*handlers.ListPostsByAuthorHandler, *listPostsByAuthorUseCaseSpy) {
+		usecase := &listPostsByAuthorUseCaseSpy{}
+		handler := handlers.NewListPostsByAuthorHandler(usecase, test.NewTestTemplateRenderer())
+		return handler, usecase
+	}
+
+	t.Run("It renders the posts of the author with a link to the feed", func(t *testing.T) {
+		handler, usecase := setup()
+		usecase.ReturnPosts = []blog.Post{post1, post2}
+
+		res := test.DoGetRequest(handler, "/authors/geison-biazus")
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, "geison-biazus", usecase.ReceivedAuthorSlug)
+		assert.Contains(t, body, "Posts by Geison Biazus")
+		assert.Contains(t, body, "/authors/geison-biazus/feed.atom")
+		assertContainsListedPost(t, body, post1)
+		assertContainsListedPost(t, body, post2)
+	})
+
+	t.Run("It responds with 404 when the author has no posts", func(t *testing.T) {
+		handler, usecase := setup()
+		usecase.ReturnPosts = []blog.Post{}
+
+		res := test.DoGetRequest(handler, "/authors/unknown")
+
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+	})
+
+	t.Run("It responds with 500 when an error is returned", func(t *testing.T) {
+		handler, usecase := setup()
+		usecase.ReturnError = errors.New("some error")
+
+		res := test.DoGetRequest(handler, "/authors/geison-biazus")
+
+		assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+	})
+}
+
+type listPostsByAuthorUseCaseSpy struct {
+	ReceivedAuthorSlug string
+	ReturnPosts        []blog.Post
+	ReturnError        error
+}
+
+func (u *listPostsByAuthorUseCaseSpy) Run(authorSlug string) ([]blog.Post, error) {
+	u.ReceivedAuthorSlug = authorSlug
+	return u.ReturnPosts, u.ReturnError
+}
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+
+	"github.com/geisonbiazus/blog/internal/ui/web/lib"
+	"github.com/geisonbiazus/blog/internal/ui/web/ports"
+)
+
+type ListPostsByTagHandler struct {
+	usecase  ports.ListPostsByTagUseCase
+	template *lib.TemplateRenderer
+}
+
+func NewListPostsByTagHandler(usecase ports.ListPostsByTagUseCase, templateRenderer *lib.TemplateRenderer) *ListPostsByTagHandler {
+	return &ListPostsByTagHandler{
+		usecase:  usecase,
+		template: templateRenderer,
+	}
+}
+
+func (h *ListPostsByTagHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	tag := path.Base(r.URL.Path)
+
+	posts, err := h.usecase.Run(tag)
+
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		h.template.Render(w, r, "500.html", nil)
+		return
+	}
+
+	if len(posts) == 0 {
+		w.WriteHeader(http.StatusNotFound)
+		h.template.Render(w, r, "404.html", nil)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	h.template.Render(w, r, "list_posts.html", listPostsViewModel{
+		Heading:  fmt.Sprintf("Posts tagged #%s", tag),
+		Posts:    toPostsViewModelList(posts),
+		FeedPath: tagPath(tag) + "/feed.atom",
+	})
+}
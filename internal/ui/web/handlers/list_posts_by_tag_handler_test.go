@@ -0,0 +1,65 @@
+package handlers_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/geisonbiazus/blog/internal/core/blog"
+	"github.com/geisonbiazus/blog/internal/ui/web/handlers"
+	"github.com/geisonbiazus/blog/internal/ui/web/test"
+	"github.com/geisonbiazus/blog/pkg/testhelper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListPostsByTagHandler(t *testing.T) {
+	setup := func() (*handlers.ListPostsByTagHandler, *listPostsByTagUseCaseSpy) {
+		usecase := &listPostsByTagUseCaseSpy{}
+		handler := handlers.NewListPostsByTagHandler(usecase, test.NewTestTemplateRenderer())
+		return handler, usecase
+	}
+
+	t.Run("It renders the posts with the given tag", func(t *testing.T) {
+		handler, usecase := setup()
+		usecase.ReturnPosts = []blog.Post{post1, post2}
+
+		res := test.DoGetRequest(handler, "/tags/go")
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, "go", usecase.ReceivedTag)
+		assert.Contains(t, body, "Posts tagged #go")
+		assertContainsListedPost(t, body, post1)
+		assertContainsListedPost(t, body, post2)
+		assert.NotContains(t, body, "tag-cloud-")
+	})
+
+	t.Run("It responds with 404 when no post has the tag", func(t *testing.T) {
+		handler, usecase := setup()
+		usecase.ReturnPosts = []blog.Post{}
+
+		res := test.DoGetRequest(handler, "/tags/unknown")
+
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+	})
+
+	t.Run("It responds with 500 when an error is returned", func(t *testing.T) {
+		handler, usecase := setup()
+		usecase.ReturnError = errors.New("some error")
+
+		res := test.DoGetRequest(handler, "/tags/go")
+
+		assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+	})
+}
+
+type listPostsByTagUseCaseSpy struct {
+	ReceivedTag string
+	ReturnPosts []blog.Post
+	ReturnError error
+}
+
+func (u *listPostsByTagUseCaseSpy) Run(tag string) ([]blog.Post, error) {
+	u.ReceivedTag = tag
+	return u.ReturnPosts, u.ReturnError
+}
@@ -3,6 +3,9 @@ package handlers
 import (
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"time"
 
 	"github.com/geisonbiazus/blog/internal/core/blog"
 	"github.com/geisonbiazus/blog/internal/ui/web/lib"
@@ -10,52 +13,233 @@ import (
 )
 
 type ListPostsHandler struct {
-	usecase  ports.ListPostUseCase
-	template *lib.TemplateRenderer
+	listPosts ports.ListPostUseCase
+	listTags  ports.ListTagsUseCase
+	template  *lib.TemplateRenderer
 }
 
-func NewListPostsHandler(usecase ports.ListPostUseCase, templateRenderer *lib.TemplateRenderer) *ListPostsHandler {
+func NewListPostsHandler(
+	listPosts ports.ListPostUseCase,
+	listTags ports.ListTagsUseCase,
+	templateRenderer *lib.TemplateRenderer,
+) *ListPostsHandler {
 	return &ListPostsHandler{
-		usecase:  usecase,
-		template: templateRenderer,
+		listPosts: listPosts,
+		listTags:  listTags,
+		template:  templateRenderer,
 	}
 }
 
 func (h *ListPostsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	posts, err := h.usecase.Run()
+	pagination, err := parsePaginationParams(r.URL.Query())
 
-	if err == nil {
-		models := h.toViewModelList(posts)
-		w.WriteHeader(http.StatusOK)
-		h.template.Render(w, "list_posts.html", models)
-	} else {
-		w.WriteHeader(http.StatusInternalServerError)
-		h.template.Render(w, "500.html", nil)
+	if err != nil {
+		h.renderNotFound(w, r)
+		return
 	}
+
+	page, err := h.listPosts.Run(pagination.page, pagination.limit)
+
+	if err == blog.ErrPageNotFound {
+		h.renderNotFound(w, r)
+		return
+	}
+
+	if err != nil {
+		h.renderServerError(w, r)
+		return
+	}
+
+	tags, err := h.listTags.Run()
+
+	if err != nil {
+		h.renderServerError(w, r)
+		return
+	}
+
+	user, _ := lib.CurrentUser(r.Context())
+	validators := lib.NewValidators(
+		time.Time{}, h.template.Version(), user.ID, string(user.Role), lib.CSRFToken(r),
+		postVersions(page.Posts...), fmt.Sprint(page.Page, page.Limit, page.TotalPosts), fmt.Sprint(tags),
+	)
+
+	if lib.NotModified(w, r, lib.CachePage, validators) {
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	h.template.Render(w, r, "list_posts.html", listPostsViewModel{
+		Heading:    "All posts",
+		Posts:      toPostsViewModelList(page.Posts),
+		Tags:       toTagCloudViewModel(tags),
+		Pagination: toPaginationViewModel(page, pagination),
+	})
+}
+
+func (h *ListPostsHandler) renderNotFound(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotFound)
+	h.template.Render(w, r, "404.html", nil)
+}
+
+func (h *ListPostsHandler) renderServerError(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusInternalServerError)
+	h.template.Render(w, r, "500.html", nil)
+}
+
+type paginationParams struct {
+	page  int
+	limit int
+}
+
+// parsePaginationParams reads the page and limit query params. A missing page
+// means the first one and a missing limit is left for the use case to decide.
+func parsePaginationParams(query url.Values) (paginationParams, error) {
+	params := paginationParams{page: 1}
+	var err error
+
+	if value := query.Get("page"); value != "" {
+		if params.page, err = strconv.Atoi(value); err != nil {
+			return params, err
+		}
+	}
+
+	if value := query.Get("limit"); value != "" {
+		if params.limit, err = strconv.Atoi(value); err != nil {
+			return params, err
+		}
+	}
+
+	return params, nil
+}
+
+func toPaginationViewModel(page blog.PostPage, params paginationParams) *paginationViewModel {
+	model := &paginationViewModel{Page: page.Page, TotalPages: page.TotalPages()}
+
+	if page.HasPrevious() {
+		model.PreviousPath = listPostsPath(page.Page-1, params.limit)
+	}
+
+	if page.HasNext() {
+		model.NextPath = listPostsPath(page.Page+1, params.limit)
+	}
+
+	return model
 }
 
-func (h *ListPostsHandler) toViewModelList(posts []blog.RenderedPost) []postsViewModel {
+// listPostsPath keeps the limit only when it was given so the default pages
+// have a single URL.
+func listPostsPath(page, limit int) string {
+	query := url.Values{}
+
+	if page > 1 {
+		query.Set("page", strconv.Itoa(page))
+	}
+
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+
+	if len(query) == 0 {
+		return "/"
+	}
+
+	return "/?" + query.Encode()
+}
+
+func toPostsViewModelList(posts []blog.Post) []postsViewModel {
 	models := []postsViewModel{}
 
 	for _, post := range posts {
-		models = append(models, h.toViewModel(post))
+		models = append(models, toPostsViewModel(post))
 	}
 
 	return models
 }
 
-func (h *ListPostsHandler) toViewModel(post blog.RenderedPost) postsViewModel {
+func toPostsViewModel(post blog.Post) postsViewModel {
 	return postsViewModel{
-		Title:  post.Post.Title,
-		Author: post.Post.Author,
-		Date:   post.Post.Time.Format(lib.DateFormat),
-		Path:   fmt.Sprintf("/posts/%s", post.Post.Path),
+		Title:       post.Title,
+		Author:      post.Author,
+		AuthorPath:  authorPath(post.AuthorSlug()),
+		Date:        post.Time.Format(lib.DateFormat),
+		Path:        fmt.Sprintf("/posts/%s", post.Path),
+		Summary:     post.Summary(),
+		ReadingTime: formatReadingTime(post.Metadata.ReadingTime),
+		Tags:        toTagsViewModel(post.Tags),
+		Params:      post.Params,
+	}
+}
+
+func toTagsViewModel(tags []string) []tagViewModel {
+	models := []tagViewModel{}
+
+	for _, tag := range tags {
+		models = append(models, tagViewModel{Name: tag, Path: tagPath(tag)})
+	}
+
+	return models
+}
+
+// toTagCloudViewModel assigns each tag a weight from 1 to tagCloudWeights
+// proportional to how many posts use it.
+func toTagCloudViewModel(counts []blog.TagCount) []tagViewModel {
+	models := []tagViewModel{}
+	maxCount := 0
+
+	for _, count := range counts {
+		if count.Count > maxCount {
+			maxCount = count.Count
+		}
+	}
+
+	for _, count := range counts {
+		models = append(models, tagViewModel{
+			Name:   count.Tag,
+			Path:   tagPath(count.Tag),
+			Count:  count.Count,
+			Weight: 1 + (count.Count-1)*(tagCloudWeights-1)/max(maxCount-1, 1),
+		})
 	}
+
+	return models
+}
+
+const tagCloudWeights = 4
+
+func tagPath(tag string) string {
+	return fmt.Sprintf("/tags/%s", url.PathEscape(tag))
+}
+
+type listPostsViewModel struct {
+	Heading    string
+	Posts      []postsViewModel
+	Tags       []tagViewModel
+	Pagination *paginationViewModel
+	FeedPath   string
 }
 
 type postsViewModel struct {
-	Title  string
+	Title       string
+	Path        string
+	Author      string
+	AuthorPath  string
+	Date        string
+	Summary     string
+	ReadingTime string
+	Tags        []tagViewModel
+	Params      map[string]interface{}
+}
+
+type paginationViewModel struct {
+	Page         int
+	TotalPages   int
+	PreviousPath string
+	NextPath     string
+}
+
+type tagViewModel struct {
+	Name   string
 	Path   string
-	Author string
-	Date   string
+	Count  int
+	Weight int
 }
@@ -4,7 +4,9 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/geisonbiazus/blog/internal/core/blog"
 	"github.com/geisonbiazus/blog/internal/ui/web/handlers"
@@ -15,39 +17,154 @@ import (
 )
 
 type listPostsHandlerFixture struct {
-	usecase *listPostUseCaseSpy
-	handler http.Handler
+	listPosts *listPostUseCaseSpy
+	listTags  *listTagsUseCaseSpy
+	handler   http.Handler
 }
 
 func TestListPostsHandler(t *testing.T) {
 	setup := func() *listPostsHandlerFixture {
-		usecase := &listPostUseCaseSpy{}
+		listPosts := &listPostUseCaseSpy{}
+		listTags := &listTagsUseCaseSpy{}
 		templateRenderer := test.NewTestTemplateRenderer()
-		handler := handlers.NewListPostsHandler(usecase, templateRenderer)
+		handler := handlers.NewListPostsHandler(listPosts, listTags, templateRenderer)
 
 		return &listPostsHandlerFixture{
-			usecase: usecase,
-			handler: handler,
+			listPosts: listPosts,
+			listTags:  listTags,
+			handler:   handler,
 		}
 	}
 
+	newPage := func(page, totalPosts int, posts ...blog.Post) blog.PostPage {
+		return blog.PostPage{Posts: posts, Page: page, Limit: 2, TotalPosts: totalPosts}
+	}
+
 	t.Run("Given a list of posts exists it renders the posts", func(t *testing.T) {
 		f := setup()
 
-		f.usecase.ReturnPosts = []blog.RenderedPost{renderedPost1, renderedPost2}
+		f.listPosts.ReturnPage = newPage(1, 2, post1, post2)
 
 		res := test.DoGetRequest(f.handler, "/index")
 		body := testhelper.ReadResponseBody(res)
 
 		assert.Equal(t, http.StatusOK, res.StatusCode)
-		assertContainsListedPost(t, body, renderedPost2)
-		assertContainsListedPost(t, body, renderedPost1)
+		assertContainsListedPost(t, body, post2)
+		assertContainsListedPost(t, body, post1)
+	})
+
+	t.Run("It renders the summary instead of the content of the posts", func(t *testing.T) {
+		f := setup()
+
+		post := post1
+		post.Description = "Post description"
+		f.listPosts.ReturnPage = newPage(1, 2, post, post2)
+
+		res := test.DoGetRequest(f.handler, "/")
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Contains(t, body, "Post description")
+		assert.Contains(t, body, "Content for post 2")
+		assert.NotContains(t, body, "<p>Content for post 2</p>")
+	})
+
+	t.Run("It renders the reading time of the posts", func(t *testing.T) {
+		f := setup()
+
+		post := post1
+		post.Metadata = blog.PostMetadata{ReadingTime: 3 * time.Minute}
+		f.listPosts.ReturnPage = newPage(1, 2, post)
+
+		res := test.DoGetRequest(f.handler, "/")
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Contains(t, body, `<span class="post-reading-time">3 min read</span>`)
+	})
+
+	t.Run("It renders the tags of each post and the tag cloud", func(t *testing.T) {
+		f := setup()
+
+		f.listPosts.ReturnPage = newPage(1, 2, post1, post2)
+		f.listTags.ReturnTags = []blog.TagCount{{Tag: "go", Count: 2}, {Tag: "testing", Count: 1}}
+
+		res := test.DoGetRequest(f.handler, "/")
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Contains(t, body, `class="tag-cloud-4 link-secondary me-2" href="/tags/go" title="2 posts"`)
+		assert.Contains(t, body, `class="tag-cloud-1 link-secondary me-2" href="/tags/testing" title="1 posts"`)
+		assert.Equal(t, 3, strings.Count(body, `class="fs-6 link-secondary me-1" href="/tags/`))
+	})
+
+	t.Run("It requests the first page with the default limit when none is given", func(t *testing.T) {
+		f := setup()
+
+		f.listPosts.ReturnPage = newPage(1, 2, post1, post2)
+
+		res := test.DoGetRequest(f.handler, "/")
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, 1, f.listPosts.ReceivedPage)
+		assert.Equal(t, 0, f.listPosts.ReceivedLimit)
+		assert.NotContains(t, body, `rel="prev"`)
+		assert.NotContains(t, body, `rel="next"`)
+		assert.NotContains(t, body, "Page 1 of 1")
+	})
+
+	t.Run("It renders the links to the previous and next pages", func(t *testing.T) {
+		f := setup()
+
+		f.listPosts.ReturnPage = newPage(2, 5, post1, post2)
+
+		res := test.DoGetRequest(f.handler, "/?page=2")
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, 2, f.listPosts.ReceivedPage)
+		assert.Contains(t, body, "<title>All posts - Page 2 | Geison Biazus</title>")
+		assert.Contains(t, body, `<link rel="prev" href="http://example.com/">`)
+		assert.Contains(t, body, `<link rel="next" href="http://example.com/?page=3">`)
+		assert.Contains(t, body, `rel="prev" href="/"`)
+		assert.Contains(t, body, `rel="next" href="/?page=3"`)
+		assert.Contains(t, body, "Page 2 of 3")
+	})
+
+	t.Run("It keeps the given limit in the page links", func(t *testing.T) {
+		f := setup()
+
+		f.listPosts.ReturnPage = newPage(2, 5, post1, post2)
+
+		res := test.DoGetRequest(f.handler, "/?page=2&limit=2")
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, 2, f.listPosts.ReceivedLimit)
+		assert.Contains(t, body, `rel="prev" href="/?limit=2"`)
+		assert.Contains(t, body, `rel="next" href="/?limit=2&amp;page=3"`)
+	})
+
+	t.Run("It renders not found for pages that don't exist", func(t *testing.T) {
+		f := setup()
+
+		f.listPosts.ReturnError = blog.ErrPageNotFound
+
+		res := test.DoGetRequest(f.handler, "/?page=10")
+
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+	})
+
+	t.Run("It renders not found for invalid pagination params", func(t *testing.T) {
+		f := setup()
+
+		res := test.DoGetRequest(f.handler, "/?page=first")
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+
+		res = test.DoGetRequest(f.handler, "/?limit=all")
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
 	})
 
 	t.Run("It renders server error when and error is returned", func(t *testing.T) {
 		f := setup()
 
-		f.usecase.ReturnError = errors.New("some error")
+		f.listPosts.ReturnError = errors.New("some error")
 
 		res := test.DoGetRequest(f.handler, "/posts")
 		body := testhelper.ReadResponseBody(res)
@@ -55,39 +172,93 @@ func TestListPostsHandler(t *testing.T) {
 		assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
 		assert.Contains(t, body, "Internal server error")
 	})
+
+	t.Run("It renders server error when the tags can't be listed", func(t *testing.T) {
+		f := setup()
+
+		f.listTags.ReturnError = errors.New("some error")
+
+		res := test.DoGetRequest(f.handler, "/")
+
+		assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+	})
+
+	t.Run("Given the client has the current version it responds with not modified", func(t *testing.T) {
+		f := setup()
+
+		f.listPosts.ReturnPage = newPage(1, 2, post1, post2)
+
+		res := test.DoGetRequest(f.handler, "/")
+
+		assert.Empty(t, res.Header.Get("Last-Modified"))
+		assert.Equal(t, "private, no-cache", res.Header.Get("Cache-Control"))
+
+		res = test.DoConditionalGetRequest(f.handler, "/", "If-None-Match", res.Header.Get("ETag"))
+
+		assert.Equal(t, http.StatusNotModified, res.StatusCode)
+	})
+
+	t.Run("Given a new post was published it responds with the list again", func(t *testing.T) {
+		f := setup()
+
+		f.listPosts.ReturnPage = newPage(1, 1, post1)
+		etag := test.DoGetRequest(f.handler, "/").Header.Get("ETag")
+		f.listPosts.ReturnPage = newPage(1, 2, post1, post2)
+
+		res := test.DoConditionalGetRequest(f.handler, "/", "If-None-Match", etag)
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+	})
 }
 
-func assertContainsListedPost(t *testing.T, body string, post blog.RenderedPost) {
+func assertContainsListedPost(t *testing.T, body string, post blog.Post) {
 	t.Helper()
-	assert.Contains(t, body, post.Post.Title)
-	assert.Contains(t, body, post.Post.Author)
-	assert.Contains(t, body, post.Post.Time.Format(lib.DateFormat))
-	assert.Contains(t, body, fmt.Sprintf("/posts/%s", post.Post.Path))
+	assert.Contains(t, body, post.Title)
+	assert.Contains(t, body, post.Author)
+	assert.Contains(t, body, post.Time.Format(lib.DateFormat))
+	assert.Contains(t, body, fmt.Sprintf("/posts/%s", post.Path))
 }
 
 type listPostUseCaseSpy struct {
-	ReturnPosts []blog.RenderedPost
+	ReceivedPage  int
+	ReceivedLimit int
+	ReturnPage    blog.PostPage
+	ReturnError   error
+}
+
+func (u *listPostUseCaseSpy) Run(page, limit int) (blog.PostPage, error) {
+	u.ReceivedPage = page
+	u.ReceivedLimit = limit
+	return u.ReturnPage, u.ReturnError
+}
+
+type listTagsUseCaseSpy struct {
+	ReturnTags  []blog.TagCount
 	ReturnError error
 }
 
-func (u *listPostUseCaseSpy) Run() ([]blog.RenderedPost, error) {
-	return u.ReturnPosts, u.ReturnError
+func (u *listTagsUseCaseSpy) Run() ([]blog.TagCount, error) {
+	return u.ReturnTags, u.ReturnError
 }
 
 var post1 = blog.Post{
 	Title:    "Test Post 1",
 	Author:   "Geison Biazus",
 	Path:     "test-post-1",
+	Tags:     []string{"go", "testing"},
 	Time:     testhelper.ParseTime("2021-04-05T18:47:00Z"),
 	Markdown: "Content for post 1",
+	Metadata: blog.NewPostMetadata("Content for post 1"),
 }
 
 var post2 = blog.Post{
 	Title:    "Test Post 2",
 	Author:   "Geison Biazus",
 	Path:     "test-post-2",
+	Tags:     []string{"go"},
 	Time:     testhelper.ParseTime("2021-04-04T14:33:00Z"),
 	Markdown: "Content for post 2",
+	Metadata: blog.NewPostMetadata("Content for post 2"),
 }
 
 var renderedPost1 = blog.RenderedPost{Post: post1, HTML: "Rendered content for post 1"}
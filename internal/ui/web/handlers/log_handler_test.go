@@ -30,6 +30,17 @@ func TestLogHandler(t *testing.T) {
 			buf.String(),
 		)
 	})
+
+	t.Run("It keeps the response writer flushable", func(t *testing.T) {
+		flushable := false
+		logHandler := handlers.NewLogHandler(log.New(&bytes.Buffer{}, "", 0), http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, flushable = w.(http.Flusher)
+		}))
+
+		test.DoGetRequest(logHandler, "/_reload")
+
+		assert.True(t, flushable)
+	})
 }
 
 func acceptedHandler() http.Handler {
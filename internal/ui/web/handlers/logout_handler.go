@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/geisonbiazus/blog/internal/ui/web/lib"
+	"github.com/geisonbiazus/blog/internal/ui/web/ports"
+)
+
+type LogoutHandler struct {
+	usecase  ports.LogoutUseCase
+	template *lib.TemplateRenderer
+}
+
+func NewLogoutHandler(usecase ports.LogoutUseCase, templateRenderer *lib.TemplateRenderer) *LogoutHandler {
+	return &LogoutHandler{
+		usecase:  usecase,
+		template: templateRenderer,
+	}
+}
+
+func (h *LogoutHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	cookie, err := r.Cookie(lib.SessionCookieName)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	err = h.usecase.Run(r.Context(), cookie.Value)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		h.template.Render(w, r, "500.html", nil)
+		return
+	}
+
+	http.SetCookie(w, lib.NewExpiredSessionCookie())
+	http.Redirect(w, r, "/", http.StatusSeeOther)
+}
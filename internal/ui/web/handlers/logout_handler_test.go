@@ -0,0 +1,93 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/geisonbiazus/blog/internal/ui/web/handlers"
+	"github.com/geisonbiazus/blog/internal/ui/web/lib"
+	"github.com/geisonbiazus/blog/internal/ui/web/test"
+	"github.com/geisonbiazus/blog/pkg/testhelper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogoutHandler(t *testing.T) {
+	type fixture struct {
+		handler *handlers.LogoutHandler
+		usecase *logoutUseCaseSpy
+	}
+
+	setup := func() fixture {
+		usecase := &logoutUseCaseSpy{}
+		templateRenderer := test.NewTestTemplateRenderer()
+		handler := handlers.NewLogoutHandler(usecase, templateRenderer)
+
+		return fixture{
+			handler: handler,
+			usecase: usecase,
+		}
+	}
+
+	newRequest := func() *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/logout", nil)
+		req.AddCookie(&http.Cookie{Name: lib.SessionCookieName, Value: "token"})
+		return req
+	}
+
+	t.Run("It revokes the session token, clears the cookie and redirects home", func(t *testing.T) {
+		f := setup()
+
+		res := test.DoRequest(f.handler, newRequest())
+
+		assert.Equal(t, "token", f.usecase.ReceivedToken)
+		assert.Equal(t, http.StatusSeeOther, res.StatusCode)
+		assert.Equal(t, "/", res.Header.Get("Location"))
+		assertSessionCookieCleared(t, res)
+	})
+
+	t.Run("It redirects home when there is no session", func(t *testing.T) {
+		f := setup()
+
+		res := test.DoRequest(f.handler, httptest.NewRequest(http.MethodPost, "/logout", nil))
+
+		assert.False(t, f.usecase.Called)
+		assert.Equal(t, http.StatusSeeOther, res.StatusCode)
+		assert.Equal(t, "/", res.Header.Get("Location"))
+	})
+
+	t.Run("It responds with 500 when the token can't be revoked", func(t *testing.T) {
+		f := setup()
+		f.usecase.ReturnError = errors.New("some error")
+
+		res := test.DoRequest(f.handler, newRequest())
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+		assert.Contains(t, body, "Internal server error")
+	})
+
+	t.Run("It responds with 405 when the method is not POST", func(t *testing.T) {
+		f := setup()
+
+		res := test.DoGetRequest(f.handler, "/logout")
+
+		assert.Equal(t, http.StatusMethodNotAllowed, res.StatusCode)
+	})
+}
+
+type logoutUseCaseSpy struct {
+	Called          bool
+	ReceivedContext context.Context
+	ReceivedToken   string
+	ReturnError     error
+}
+
+func (u *logoutUseCaseSpy) Run(ctx context.Context, token string) error {
+	u.Called = true
+	u.ReceivedContext = ctx
+	u.ReceivedToken = token
+	return u.ReturnError
+}
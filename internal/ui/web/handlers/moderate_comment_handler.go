@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/geisonbiazus/blog/internal/core/auth"
+	"github.com/geisonbiazus/blog/internal/core/discussion"
+	"github.com/geisonbiazus/blog/internal/ui/web/lib"
+	"github.com/geisonbiazus/blog/internal/ui/web/ports"
+)
+
+type ModerateCommentHandler struct {
+	usecase  ports.ModerateCommentUseCase
+	template *lib.TemplateRenderer
+}
+
+func NewModerateCommentHandler(usecase ports.ModerateCommentUseCase, templateRenderer *lib.TemplateRenderer) *ModerateCommentHandler {
+	return &ModerateCommentHandler{
+		usecase:  usecase,
+		template: templateRenderer,
+	}
+}
+
+func (h *ModerateCommentHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	user, ok := lib.CurrentUser(r.Context())
+	if !ok || !user.Can(auth.PermissionModerateComments) {
+		h.respondWithNotFound(w, r)
+		return
+	}
+
+	status := discussion.CommentStatus(r.PostFormValue("status"))
+
+	err := h.usecase.Run(r.Context(), user.ID, r.PostFormValue("comment_id"), status)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	http.Redirect(w, r, "/admin/comments", http.StatusSeeOther)
+}
+
+func (h *ModerateCommentHandler) handleError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, discussion.ErrCommentNotFound) || errors.Is(err, discussion.ErrNotModerator) {
+		h.respondWithNotFound(w, r)
+		return
+	}
+
+	if errors.Is(err, discussion.ErrInvalidCommentStatus) {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	w.WriteHeader(http.StatusInternalServerError)
+	h.template.Render(w, r, "500.html", nil)
+}
+
+func (h *ModerateCommentHandler) respondWithNotFound(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotFound)
+	h.template.Render(w, r, "404.html", nil)
+}
@@ -0,0 +1,121 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/geisonbiazus/blog/internal/core/auth"
+	"github.com/geisonbiazus/blog/internal/core/discussion"
+	"github.com/geisonbiazus/blog/internal/ui/web/handlers"
+	"github.com/geisonbiazus/blog/internal/ui/web/lib"
+	"github.com/geisonbiazus/blog/internal/ui/web/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModerateCommentHandler(t *testing.T) {
+	type fixture struct {
+		handler *handlers.ModerateCommentHandler
+		usecase *moderateCommentUseCaseSpy
+	}
+
+	setup := func() fixture {
+		usecase := &moderateCommentUseCaseSpy{}
+		handler := handlers.NewModerateCommentHandler(usecase, test.NewTestTemplateRenderer())
+
+		return fixture{handler: handler, usecase: usecase}
+	}
+
+	form := url.Values{
+		"comment_id": {"COMMENT_ID"},
+		"status":     {"approved"},
+	}
+
+	newAdminRequest := func() *http.Request {
+		req := test.NewPostFormRequest("/admin/comments/moderate", form)
+		return req.WithContext(lib.WithCurrentUser(req.Context(), auth.User{ID: "USER_ID", Role: auth.RoleAdmin}))
+	}
+
+	t.Run("It moderates the comment and redirects back to the queue", func(t *testing.T) {
+		f := setup()
+
+		res := test.DoRequest(f.handler, newAdminRequest())
+
+		assert.Equal(t, "USER_ID", f.usecase.ReceivedModeratorUserID)
+		assert.Equal(t, "COMMENT_ID", f.usecase.ReceivedCommentID)
+		assert.Equal(t, discussion.CommentApproved, f.usecase.ReceivedStatus)
+		assert.Equal(t, http.StatusSeeOther, res.StatusCode)
+		assert.Equal(t, "/admin/comments", res.Header.Get("Location"))
+	})
+
+	t.Run("It responds with 404 when the current user is not an admin", func(t *testing.T) {
+		f := setup()
+
+		res := test.DoRequest(f.handler, newAuthenticatedFormRequest("/admin/comments/moderate", form))
+
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+		assert.Equal(t, "", f.usecase.ReceivedCommentID)
+	})
+
+	t.Run("It responds with 404 when the comment doesn't exist", func(t *testing.T) {
+		f := setup()
+		f.usecase.ReturnError = discussion.ErrCommentNotFound
+
+		res := test.DoRequest(f.handler, newAdminRequest())
+
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+	})
+
+	t.Run("It responds with 404 when the use case doesn't let the user moderate", func(t *testing.T) {
+		f := setup()
+		f.usecase.ReturnError = discussion.ErrNotModerator
+
+		res := test.DoRequest(f.handler, newAdminRequest())
+
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+	})
+
+	t.Run("It responds with 400 when the status is invalid", func(t *testing.T) {
+		f := setup()
+		f.usecase.ReturnError = discussion.ErrInvalidCommentStatus
+
+		res := test.DoRequest(f.handler, newAdminRequest())
+
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+	})
+
+	t.Run("It responds with 500 when an unrecognized error is returned", func(t *testing.T) {
+		f := setup()
+		f.usecase.ReturnError = errors.New("some error")
+
+		res := test.DoRequest(f.handler, newAdminRequest())
+
+		assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+	})
+
+	t.Run("It responds with 405 when the method is not POST", func(t *testing.T) {
+		f := setup()
+
+		res := test.DoGetRequest(f.handler, "/admin/comments/moderate")
+
+		assert.Equal(t, http.StatusMethodNotAllowed, res.StatusCode)
+	})
+}
+
+type moderateCommentUseCaseSpy struct {
+	ReceivedContext         context.Context
+	ReceivedModeratorUserID string
+	ReceivedCommentID       string
+	ReceivedStatus          discussion.CommentStatus
+	ReturnError             error
+}
+
+func (u *moderateCommentUseCaseSpy) Run(ctx context.Context, moderatorUserID, commentID string, status discussion.CommentStatus) error {
+	u.ReceivedContext = ctx
+	u.ReceivedModeratorUserID = moderatorUserID
+	u.ReceivedCommentID = commentID
+	u.ReceivedStatus = status
+	return u.ReturnError
+}
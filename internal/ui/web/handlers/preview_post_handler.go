@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"net/http"
+	"path"
+
+	"github.com/geisonbiazus/blog/internal/core/blog"
+	"github.com/geisonbiazus/blog/internal/ui/web/lib"
+	"github.com/geisonbiazus/blog/internal/ui/web/ports"
+)
+
+type PreviewPostHandler struct {
+	usecase  ports.PreviewPostUseCase
+	template *lib.TemplateRenderer
+}
+
+func NewPreviewPostHandler(usecase ports.PreviewPostUseCase, templateRenderer *lib.TemplateRenderer) *PreviewPostHandler {
+	return &PreviewPostHandler{
+		usecase:  usecase,
+		template: templateRenderer,
+	}
+}
+
+func (h *PreviewPostHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	renderedPost, err := h.usecase.Run(path.Base(r.URL.Path), r.URL.Query().Get("signature"))
+
+	if err == blog.ErrPostNotFound || err == blog.ErrInvalidPreviewSignature {
+		w.WriteHeader(http.StatusNotFound)
+		h.template.Render(w, r, "404.html", nil)
+		return
+	}
+
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		h.template.Render(w, r, "500.html", nil)
+		return
+	}
+
+	viewModel := toPostViewModel(renderedPost)
+	viewModel.Preview = true
+
+	w.WriteHeader(http.StatusOK)
+	h.template.Render(w, r, "view_post.html", viewModel)
+}
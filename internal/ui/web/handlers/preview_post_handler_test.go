@@ -0,0 +1,70 @@
+package handlers_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/geisonbiazus/blog/internal/core/blog"
+	"github.com/geisonbiazus/blog/internal/ui/web/handlers"
+	"github.com/geisonbiazus/blog/internal/ui/web/test"
+	"github.com/geisonbiazus/blog/pkg/testhelper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreviewPostHandler(t *testing.T) {
+	setup := func() (*handlers.PreviewPostHandler, *previewPostUseCaseSpy) {
+		usecase := &previewPostUseCaseSpy{}
+		handler := handlers.NewPreviewPostHandler(usecase, test.NewTestTemplateRenderer())
+		return handler, usecase
+	}
+
+	t.Run("It renders the post as a preview without comments", func(t *testing.T) {
+		handler, usecase := setup()
+		usecase.ReturnPost = buildRenderedPost()
+
+		res := test.DoGetRequest(handler, "/preview/post-path?signature=sig")
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, "post-path", usecase.ReceivedPath)
+		assert.Equal(t, "sig", usecase.ReceivedSignature)
+		assert.Contains(t, body, "This is a preview")
+		assert.Contains(t, body, `<meta name="robots" content="noindex" />`)
+		assert.Contains(t, body, usecase.ReturnPost.HTML)
+		assert.NotContains(t, body, `action="/comments"`)
+	})
+
+	t.Run("It responds with not found for invalid signatures and unknown posts", func(t *testing.T) {
+		for _, err := range []error{blog.ErrInvalidPreviewSignature, blog.ErrPostNotFound} {
+			handler, usecase := setup()
+			usecase.ReturnError = err
+
+			res := test.DoGetRequest(handler, "/preview/post-path?signature=sig")
+
+			assert.Equal(t, http.StatusNotFound, res.StatusCode)
+		}
+	})
+
+	t.Run("It responds with server error when other error happens", func(t *testing.T) {
+		handler, usecase := setup()
+		usecase.ReturnError = errors.New("any error")
+
+		res := test.DoGetRequest(handler, "/preview/post-path?signature=sig")
+
+		assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+	})
+}
+
+type previewPostUseCaseSpy struct {
+	ReceivedPath      string
+	ReceivedSignature string
+	ReturnPost        blog.RenderedPost
+	ReturnError       error
+}
+
+func (u *previewPostUseCaseSpy) Run(path, signature string) (blog.RenderedPost, error) {
+	u.ReceivedPath = path
+	u.ReceivedSignature = signature
+	return u.ReturnPost, u.ReturnError
+}
@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/geisonbiazus/blog/internal/ui/web/lib"
+)
+
+// ReloadHandler streams a server-sent event to the browser when the content
+// changes so the page can be reloaded.
+type ReloadHandler struct {
+	reloader *lib.Reloader
+}
+
+func NewReloadHandler(reloader *lib.Reloader) *ReloadHandler {
+	return &ReloadHandler{reloader: reloader}
+}
+
+func (h *ReloadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+
+	if !ok {
+		http.Error(w, "Streaming not supported", http.StatusInternalServerError)
+		return
+	}
+
+	reload, unsubscribe := h.reloader.Subscribe()
+	defer unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, "retry: 1000\n\n")
+	flusher.Flush()
+
+	select {
+	case <-reload:
+		fmt.Fprint(w, "data: reload\n\n")
+		flusher.Flush()
+	case <-r.Context().Done():
+	}
+}
@@ -0,0 +1,79 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/geisonbiazus/blog/internal/ui/web/handlers"
+	"github.com/geisonbiazus/blog/internal/ui/web/lib"
+	"github.com/geisonbiazus/blog/pkg/testhelper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReloadHandler(t *testing.T) {
+	serve := func(handler http.Handler, req *http.Request) chan *http.Response {
+		responses := make(chan *http.Response)
+
+		go func() {
+			rw := httptest.NewRecorder()
+			handler.ServeHTTP(rw, req)
+			responses <- rw.Result()
+		}()
+
+		return responses
+	}
+
+	waitForResponse := func(t *testing.T, responses chan *http.Response) *http.Response {
+		t.Helper()
+
+		select {
+		case res := <-responses:
+			return res
+		case <-time.After(time.Second):
+			t.Fatal("expected the handler to respond")
+			return nil
+		}
+	}
+
+	t.Run("It sends a reload event when the content changes", func(t *testing.T) {
+		reloader := lib.NewReloader()
+		handler := handlers.NewReloadHandler(reloader)
+
+		responses := serve(handler, httptest.NewRequest(http.MethodGet, "/_reload", nil))
+
+		var res *http.Response
+		assert.Eventually(t, func() bool {
+			reloader.Reload()
+
+			select {
+			case res = <-responses:
+				return true
+			default:
+				return false
+			}
+		}, time.Second, 10*time.Millisecond)
+
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, "text/event-stream", res.Header.Get("Content-Type"))
+		assert.Contains(t, body, "data: reload\n\n")
+	})
+
+	t.Run("It stops streaming when the client disconnects", func(t *testing.T) {
+		reloader := lib.NewReloader()
+		handler := handlers.NewReloadHandler(reloader)
+		ctx, cancel := context.WithCancel(context.Background())
+		req := httptest.NewRequest(http.MethodGet, "/_reload", nil).WithContext(ctx)
+
+		responses := serve(handler, req)
+		cancel()
+
+		res := waitForResponse(t, responses)
+
+		assert.NotContains(t, testhelper.ReadResponseBody(res), "data: reload")
+	})
+}
@@ -21,7 +21,7 @@ func (h *RequestOAuth2Handler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
-		h.template.Render(w, "500.html", nil)
+		h.template.Render(w, r, "500.html", nil)
 		return
 	}
 
@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type RobotsHandler struct {
+	baseURL  string
+	disallow []string
+}
+
+// NewRobotsHandler serves a robots.txt allowing every crawler except on the
+// disallowed paths and pointing them to the sitemap.
+func NewRobotsHandler(baseURL string, disallow []string) *RobotsHandler {
+	return &RobotsHandler{
+		baseURL:  baseURL,
+		disallow: disallow,
+	}
+}
+
+func (h *RobotsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Add("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(h.content()))
+}
+
+func (h *RobotsHandler) content() string {
+	var b strings.Builder
+
+	b.WriteString("User-agent: *\n")
+
+	if len(h.disallow) == 0 {
+		b.WriteString("Disallow:\n")
+	}
+
+	for _, path := range h.disallow {
+		fmt.Fprintf(&b, "Disallow: %s\n", path)
+	}
+
+	fmt.Fprintf(&b, "\nSitemap: %s/sitemap.xml\n", h.baseURL)
+
+	return b.String()
+}
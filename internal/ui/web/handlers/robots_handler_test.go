@@ -0,0 +1,39 @@
+package handlers_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/geisonbiazus/blog/internal/ui/web/handlers"
+	"github.com/geisonbiazus/blog/internal/ui/web/test"
+	"github.com/geisonbiazus/blog/pkg/testhelper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRobotsHandler(t *testing.T) {
+	t.Run("It disallows the given paths and points to the sitemap", func(t *testing.T) {
+		handler := handlers.NewRobotsHandler("http://example.com", []string{"/admin/", "/preview/"})
+
+		res := test.DoGetRequest(handler, "/robots.txt")
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, "text/plain; charset=utf-8", res.Header.Get("Content-Type"))
+		assert.Equal(t, ""+
+			"User-agent: *\n"+
+			"Disallow: /admin/\n"+
+			"Disallow: /preview/\n"+
+			"\n"+
+			"Sitemap: http://example.com/sitemap.xml\n",
+			body)
+	})
+
+	t.Run("Given no disallowed paths it allows everything", func(t *testing.T) {
+		handler := handlers.NewRobotsHandler("http://example.com", nil)
+
+		res := test.DoGetRequest(handler, "/robots.txt")
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, "User-agent: *\nDisallow:\n\nSitemap: http://example.com/sitemap.xml\n", body)
+	})
+}
@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/geisonbiazus/blog/internal/core/blog"
+	"github.com/geisonbiazus/blog/internal/ui/web/lib"
+	"github.com/geisonbiazus/blog/internal/ui/web/ports"
+)
+
+type SearchPostsHandler struct {
+	usecase  ports.SearchPostsUseCase
+	template *lib.TemplateRenderer
+}
+
+func NewSearchPostsHandler(usecase ports.SearchPostsUseCase, templateRenderer *lib.TemplateRenderer) *SearchPostsHandler {
+	return &SearchPostsHandler{
+		usecase:  usecase,
+		template: templateRenderer,
+	}
+}
+
+func (h *SearchPostsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	query := strings.TrimSpace(r.URL.Query().Get("q"))
+
+	results, err := h.usecase.Run(query)
+
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		h.template.Render(w, r, "500.html", nil)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	h.template.Render(w, r, "search.html", searchViewModel{
+		Query:   query,
+		Results: toSearchResultsViewModel(results),
+	})
+}
+
+type searchViewModel struct {
+	Query   string
+	Results []searchResultViewModel
+}
+
+type searchResultViewModel struct {
+	Title   string
+	Path    string
+	Date    string
+	Snippet blog.Snippet
+}
+
+func toSearchResultsViewModel(results []blog.SearchResult) []searchResultViewModel {
+	models := []searchResultViewModel{}
+
+	for _, result := range results {
+		models = append(models, searchResultViewModel{
+			Title:   result.Post.Title,
+			Path:    fmt.Sprintf("/posts/%s", result.Post.Path),
+			Date:    result.Post.Time.Format(lib.DateFormat),
+			Snippet: result.Snippet,
+		})
+	}
+
+	return models
+}
@@ -0,0 +1,85 @@
+package handlers_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/geisonbiazus/blog/internal/core/blog"
+	"github.com/geisonbiazus/blog/internal/ui/web/handlers"
+	"github.com/geisonbiazus/blog/internal/ui/web/test"
+	"github.com/geisonbiazus/blog/pkg/testhelper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchPostsHandler(t *testing.T) {
+	setup := func() (*handlers.SearchPostsHandler, *searchPostsUseCaseSpy) {
+		usecase := &searchPostsUseCaseSpy{}
+		handler := handlers.NewSearchPostsHandler(usecase, test.NewTestTemplateRenderer())
+		return handler, usecase
+	}
+
+	t.Run("It renders the results with the highlighted snippets", func(t *testing.T) {
+		handler, usecase := setup()
+		usecase.ReturnResults = []blog.SearchResult{{
+			Post: renderedPost1.Post,
+			Snippet: blog.Snippet{
+				{Text: "A "},
+				{Text: "mock", Highlighted: true},
+				{Text: " <b>is</b> a test double"},
+			},
+		}}
+
+		res := test.DoGetRequest(handler, "/search?q=+mock+")
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, "mock", usecase.ReceivedQuery)
+		assert.Contains(t, body, `value="mock"`)
+		assert.Contains(t, body, `href="/posts/test-post-1"`)
+		assert.Contains(t, body, renderedPost1.Post.Title)
+		assert.Contains(t, body, "A <mark>mock</mark> &lt;b&gt;is&lt;/b&gt; a test double")
+	})
+
+	t.Run("It renders a message when nothing is found", func(t *testing.T) {
+		handler, usecase := setup()
+		usecase.ReturnResults = []blog.SearchResult{}
+
+		res := test.DoGetRequest(handler, "/search?q=unknown")
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Contains(t, body, `No posts found for "unknown".`)
+	})
+
+	t.Run("It renders only the form when there is no query", func(t *testing.T) {
+		handler, _ := setup()
+
+		res := test.DoGetRequest(handler, "/search")
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Contains(t, body, `action="/search"`)
+		assert.NotContains(t, body, "No posts found")
+	})
+
+	t.Run("It responds with 500 when an error is returned", func(t *testing.T) {
+		handler, usecase := setup()
+		usecase.ReturnError = errors.New("some error")
+
+		res := test.DoGetRequest(handler, "/search?q=mock")
+
+		assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+	})
+}
+
+type searchPostsUseCaseSpy struct {
+	ReceivedQuery string
+	ReturnResults []blog.SearchResult
+	ReturnError   error
+}
+
+func (u *searchPostsUseCaseSpy) Run(query string) ([]blog.SearchResult, error) {
+	u.ReceivedQuery = query
+	return u.ReturnResults, u.ReturnError
+}
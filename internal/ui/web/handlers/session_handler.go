@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/geisonbiazus/blog/internal/core/auth"
+	"github.com/geisonbiazus/blog/internal/ui/web/lib"
+	"github.com/geisonbiazus/blog/internal/ui/web/ports"
+)
+
+type SessionHandler struct {
+	usecase ports.AuthenticateTokenUseCase
+	handler http.Handler
+}
+
+// NewSessionHandler authenticates the requests with the session cookie. As the
+// browser sends the cookie on requests made by other sites too, authenticated
+// requests that change anything must carry the CSRF token of the session.
+func NewSessionHandler(usecase ports.AuthenticateTokenUseCase, handler http.Handler) *SessionHandler {
+	return &SessionHandler{usecase: usecase, handler: handler}
+}
+
+func (h *SessionHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	cookie, err := r.Cookie(lib.SessionCookieName)
+	if err != nil {
+		h.handler.ServeHTTP(w, r)
+		return
+	}
+
+	user, err := h.usecase.Run(r.Context(), cookie.Value)
+	if err != nil {
+		if h.isInvalidSession(err) {
+			http.SetCookie(w, lib.NewExpiredSessionCookie())
+		}
+
+		h.handler.ServeHTTP(w, r)
+		return
+	}
+
+	if !lib.IsSafeMethod(r.Method) && !lib.ValidCSRFToken(r) {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	h.handler.ServeHTTP(w, r.WithContext(lib.WithCurrentUser(r.Context(), user)))
+}
+
+func (h *SessionHandler) isInvalidSession(err error) bool {
+	return errors.Is(err, auth.ErrTokenExpired) ||
+		errors.Is(err, auth.ErrInvalidToken) ||
+		errors.Is(err, auth.ErrTokenRevoked) ||
+		errors.Is(err, auth.ErrUserNotFound)
+}
@@ -0,0 +1,189 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/geisonbiazus/blog/internal/core/auth"
+	"github.com/geisonbiazus/blog/internal/ui/web/handlers"
+	"github.com/geisonbiazus/blog/internal/ui/web/lib"
+	"github.com/geisonbiazus/blog/internal/ui/web/test"
+	"github.com/geisonbiazus/blog/pkg/testhelper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionHandler(t *testing.T) {
+	type fixture struct {
+		handler *handlers.SessionHandler
+		usecase *authenticateTokenUseCaseSpy
+	}
+
+	setup := func() fixture {
+		usecase := &authenticateTokenUseCaseSpy{}
+		handler := handlers.NewSessionHandler(usecase, currentUserHandler())
+
+		return fixture{
+			handler: handler,
+			usecase: usecase,
+		}
+	}
+
+	newRequest := func() *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.AddCookie(&http.Cookie{Name: lib.SessionCookieName, Value: "token"})
+		return req
+	}
+
+	t.Run("It puts the authenticated user on the request context", func(t *testing.T) {
+		f := setup()
+		f.usecase.ReturnUser = auth.User{ID: "USER_ID", Name: "User Name"}
+
+		res := test.DoRequest(f.handler, newRequest())
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, "token", f.usecase.ReceivedToken)
+		assert.Equal(t, "User Name", body)
+		assert.Empty(t, res.Cookies())
+	})
+
+	t.Run("It serves the request anonymously when there is no session", func(t *testing.T) {
+		f := setup()
+
+		res := test.DoGetRequest(f.handler, "/")
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, "", f.usecase.ReceivedToken)
+		assert.Equal(t, "anonymous", body)
+	})
+
+	t.Run("It clears the session cookie when the token is expired", func(t *testing.T) {
+		f := setup()
+		f.usecase.ReturnError = auth.ErrTokenExpired
+
+		res := test.DoRequest(f.handler, newRequest())
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, "anonymous", body)
+		assertSessionCookieCleared(t, res)
+	})
+
+	t.Run("It clears the session cookie when the token is invalid", func(t *testing.T) {
+		f := setup()
+		f.usecase.ReturnError = auth.ErrInvalidToken
+
+		res := test.DoRequest(f.handler, newRequest())
+
+		assertSessionCookieCleared(t, res)
+	})
+
+	t.Run("It clears the session cookie when the token was revoked", func(t *testing.T) {
+		f := setup()
+		f.usecase.ReturnError = auth.ErrTokenRevoked
+
+		res := test.DoRequest(f.handler, newRequest())
+
+		assertSessionCookieCleared(t, res)
+	})
+
+	t.Run("It clears the session cookie when the user doesn't exist", func(t *testing.T) {
+		f := setup()
+		f.usecase.ReturnError = auth.ErrUserNotFound
+
+		res := test.DoRequest(f.handler, newRequest())
+
+		assertSessionCookieCleared(t, res)
+	})
+
+	newPostRequest := func(form url.Values) *http.Request {
+		req := test.NewPostFormRequest("/", form)
+		req.AddCookie(&http.Cookie{Name: lib.SessionCookieName, Value: "token"})
+		return req
+	}
+
+	t.Run("It serves authenticated POST requests carrying the CSRF token", func(t *testing.T) {
+		f := setup()
+		f.usecase.ReturnUser = auth.User{ID: "USER_ID", Name: "User Name"}
+		token := lib.CSRFToken(newRequest())
+
+		res := test.DoRequest(f.handler, newPostRequest(url.Values{lib.CSRFFieldName: {token}}))
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, "User Name", body)
+	})
+
+	t.Run("It accepts the CSRF token as a header", func(t *testing.T) {
+		f := setup()
+		f.usecase.ReturnUser = auth.User{ID: "USER_ID", Name: "User Name"}
+		req := newPostRequest(url.Values{})
+		req.Header.Set(lib.CSRFHeaderName, lib.CSRFToken(req))
+
+		res := test.DoRequest(f.handler, req)
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+	})
+
+	t.Run("It responds with 403 to authenticated POST requests without a valid CSRF token", func(t *testing.T) {
+		f := setup()
+		f.usecase.ReturnUser = auth.User{ID: "USER_ID", Name: "User Name"}
+
+		for _, form := range []url.Values{{}, {lib.CSRFFieldName: {"invalid"}}} {
+			res := test.DoRequest(f.handler, newPostRequest(form))
+			body := testhelper.ReadResponseBody(res)
+
+			assert.Equal(t, http.StatusForbidden, res.StatusCode)
+			assert.Equal(t, "", body)
+		}
+	})
+
+	t.Run("It keeps the session cookie on unrecognized errors", func(t *testing.T) {
+		f := setup()
+		f.usecase.ReturnError = errors.New("some error")
+
+		res := test.DoRequest(f.handler, newRequest())
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, "anonymous", body)
+		assert.Empty(t, res.Cookies())
+	})
+}
+
+func assertSessionCookieCleared(t *testing.T, res *http.Response) {
+	cookies := res.Cookies()
+
+	if assert.Len(t, cookies, 1) {
+		assert.Equal(t, lib.SessionCookieName, cookies[0].Name)
+		assert.Equal(t, "", cookies[0].Value)
+		assert.Equal(t, -1, cookies[0].MaxAge)
+	}
+}
+
+func currentUserHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, ok := lib.CurrentUser(r.Context())
+
+		if !ok {
+			w.Write([]byte("anonymous"))
+			return
+		}
+
+		w.Write([]byte(user.Name))
+	})
+}
+
+type authenticateTokenUseCaseSpy struct {
+	ReceivedContext context.Context
+	ReceivedToken   string
+	ReturnUser      auth.User
+	ReturnError     error
+}
+
+func (u *authenticateTokenUseCaseSpy) Run(ctx context.Context, token string) (auth.User, error) {
+	u.ReceivedContext = ctx
+	u.ReceivedToken = token
+	return u.ReturnUser, u.ReturnError
+}
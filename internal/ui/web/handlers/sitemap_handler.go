@@ -0,0 +1,153 @@
+package handlers
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/geisonbiazus/blog/internal/core/blog"
+	"github.com/geisonbiazus/blog/internal/ui/web/lib"
+	"github.com/geisonbiazus/blog/internal/ui/web/ports"
+)
+
+type SitemapHandler struct {
+	usecase  ports.ListPostUseCase
+	template *lib.TemplateRenderer
+	baseURL  string
+}
+
+func NewSitemapHandler(usecase ports.ListPostUseCase, templateRenderer *lib.TemplateRenderer, baseURL string) *SitemapHandler {
+	return &SitemapHandler{
+		usecase:  usecase,
+		template: templateRenderer,
+		baseURL:  baseURL,
+	}
+}
+
+func (h *SitemapHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	posts, err := h.allPosts()
+
+	if err != nil {
+		h.renderServerError(w, r)
+	} else {
+		h.renderSitemap(w, posts)
+	}
+}
+
+// allPosts goes through every page of the published posts, newest first.
+func (h *SitemapHandler) allPosts() ([]blog.Post, error) {
+	posts := []blog.Post{}
+
+	for page := 1; ; page++ {
+		result, err := h.usecase.Run(page, blog.MaxPostsPerPage)
+
+		if err != nil {
+			return posts, err
+		}
+
+		posts = append(posts, result.Posts...)
+
+		if !result.HasNext() {
+			return posts, nil
+		}
+	}
+}
+
+func (h *SitemapHandler) renderServerError(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusInternalServerError)
+	h.template.Render(w, r, "500.html", nil)
+}
+
+func (h *SitemapHandler) renderSitemap(w http.ResponseWriter, posts []blog.Post) {
+	w.Header().Add("Content-Type", "application/xml")
+	w.WriteHeader(http.StatusOK)
+
+	w.Write([]byte(xml.Header))
+
+	if err := xml.NewEncoder(w).Encode(h.buildSitemap(posts)); err != nil {
+		panic(fmt.Sprintf("Something went wrong rendering the sitemap: %v", err))
+	}
+}
+
+// buildSitemap lists the home and about pages followed by the posts, tags and
+// series. Tags and series were last modified by their newest post.
+func (h *SitemapHandler) buildSitemap(posts []blog.Post) *sitemap {
+	result := &sitemap{Namespace: sitemapNamespace}
+	tags := &sitemapURLs{}
+	series := &sitemapURLs{}
+
+	result.add(h.newURL("/", newestModification(posts)))
+	result.add(h.newURL("/about", time.Time{}))
+
+	for _, post := range posts {
+		result.add(h.newURL(fmt.Sprintf("/posts/%s", post.Path), post.LastModified()))
+
+		for _, tag := range post.Tags {
+			tags.add(h.newURL(tagPath(tag), post.LastModified()))
+		}
+
+		if post.Series != "" {
+			series.add(h.newURL(seriesPath(post.SeriesSlug()), post.LastModified()))
+		}
+	}
+
+	result.URLs = append(result.URLs, tags.URLs...)
+	result.URLs = append(result.URLs, series.URLs...)
+
+	return result
+}
+
+func (h *SitemapHandler) newURL(path string, lastModified time.Time) sitemapURL {
+	url := sitemapURL{Location: h.baseURL + path}
+
+	if !lastModified.IsZero() {
+		url.LastModified = lastModified.UTC().Format(sitemapDateFormat)
+	}
+
+	return url
+}
+
+func newestModification(posts []blog.Post) time.Time {
+	newest := time.Time{}
+
+	for _, post := range posts {
+		if post.LastModified().After(newest) {
+			newest = post.LastModified()
+		}
+	}
+
+	return newest
+}
+
+const sitemapNamespace = "http://www.sitemaps.org/schemas/sitemap/0.9"
+const sitemapDateFormat = "2006-01-02"
+
+type sitemap struct {
+	XMLName   xml.Name `xml:"urlset"`
+	Namespace string   `xml:"xmlns,attr"`
+	sitemapURLs
+}
+
+type sitemapURLs struct {
+	URLs []sitemapURL `xml:"url"`
+}
+
+// add keeps a single entry per location with the latest modification date.
+func (s *sitemapURLs) add(url sitemapURL) {
+	for i, existing := range s.URLs {
+		if existing.Location == url.Location {
+			if url.LastModified > existing.LastModified {
+				s.URLs[i].LastModified = url.LastModified
+			}
+			return
+		}
+	}
+
+	s.URLs = append(s.URLs, url)
+}
+
+type sitemapURL struct {
+	Location     string `xml:"loc"`
+	LastModified string `xml:"lastmod,omitempty"`
+}
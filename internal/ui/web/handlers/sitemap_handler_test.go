@@ -0,0 +1,123 @@
+package handlers_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/geisonbiazus/blog/internal/core/blog"
+	"github.com/geisonbiazus/blog/internal/ui/web/handlers"
+	"github.com/geisonbiazus/blog/internal/ui/web/test"
+	"github.com/geisonbiazus/blog/pkg/testhelper"
+	"github.com/stretchr/testify/assert"
+)
+
+type sitemapHandlerFixture struct {
+	usecase *pagedListPostsUseCaseSpy
+	handler http.Handler
+}
+
+func TestSitemapHandler(t *testing.T) {
+	setup := func() *sitemapHandlerFixture {
+		usecase := &pagedListPostsUseCaseSpy{}
+		templateRenderer := test.NewTestTemplateRenderer()
+		handler := handlers.NewSitemapHandler(usecase, templateRenderer, "http://example.com")
+
+		return &sitemapHandlerFixture{
+			usecase: usecase,
+			handler: handler,
+		}
+	}
+
+	t.Run("It lists the pages, posts, tags and series with their last modification", func(t *testing.T) {
+		f := setup()
+
+		updatedPost := post1
+		updatedPost.UpdatedAt = testhelper.ParseTime("2021-05-10T08:00:00Z")
+		seriesPost := post2
+		seriesPost.Series = "Data Structures"
+		f.usecase.ReturnPosts = []blog.Post{updatedPost, seriesPost}
+
+		res := test.DoGetRequest(f.handler, "/sitemap.xml")
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, "application/xml", res.Header.Get("Content-Type"))
+		assert.Equal(t, removeWhiteSpaces(expectedSitemap), removeWhiteSpaces(body))
+	})
+
+	t.Run("It goes through every page of posts", func(t *testing.T) {
+		f := setup()
+
+		posts := []blog.Post{}
+		for i := 0; i < blog.MaxPostsPerPage+1; i++ {
+			posts = append(posts, post1)
+		}
+		f.usecase.ReturnPosts = posts
+
+		res := test.DoGetRequest(f.handler, "/sitemap.xml")
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, []int{1, 2}, f.usecase.ReceivedPages)
+		assert.Equal(t, blog.MaxPostsPerPage, f.usecase.ReceivedLimit)
+	})
+
+	t.Run("Given no posts it lists only the pages", func(t *testing.T) {
+		f := setup()
+
+		res := test.DoGetRequest(f.handler, "/sitemap.xml")
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, removeWhiteSpaces(`<?xml version="1.0" encoding="UTF-8"?>
+			<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">
+				<url><loc>http://example.com/</loc></url>
+				<url><loc>http://example.com/about</loc></url>
+			</urlset>`), removeWhiteSpaces(body))
+	})
+
+	t.Run("Given an error occurs on getting the posts it returns 500", func(t *testing.T) {
+		f := setup()
+
+		f.usecase.ReturnError = errors.New("any error")
+
+		res := test.DoGetRequest(f.handler, "/sitemap.xml")
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+		assert.Contains(t, body, "Internal server error")
+	})
+}
+
+var expectedSitemap = `<?xml version="1.0" encoding="UTF-8"?>
+<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">
+	<url><loc>http://example.com/</loc><lastmod>2021-05-10</lastmod></url>
+	<url><loc>http://example.com/about</loc></url>
+	<url><loc>http://example.com/posts/test-post-1</loc><lastmod>2021-05-10</lastmod></url>
+	<url><loc>http://example.com/posts/test-post-2</loc><lastmod>2021-04-04</lastmod></url>
+	<url><loc>http://example.com/tags/go</loc><lastmod>2021-05-10</lastmod></url>
+	<url><loc>http://example.com/tags/testing</loc><lastmod>2021-05-10</lastmod></url>
+	<url><loc>http://example.com/series/data-structures</loc><lastmod>2021-04-04</lastmod></url>
+</urlset>`
+
+// pagedListPostsUseCaseSpy splits the posts in pages of the requested limit.
+type pagedListPostsUseCaseSpy struct {
+	ReceivedPages []int
+	ReceivedLimit int
+	ReturnPosts   []blog.Post
+	ReturnError   error
+}
+
+func (u *pagedListPostsUseCaseSpy) Run(page, limit int) (blog.PostPage, error) {
+	u.ReceivedPages = append(u.ReceivedPages, page)
+	u.ReceivedLimit = limit
+
+	start := min((page-1)*limit, len(u.ReturnPosts))
+	end := min(start+limit, len(u.ReturnPosts))
+
+	return blog.PostPage{
+		Posts:      u.ReturnPosts[start:end],
+		Page:       page,
+		Limit:      limit,
+		TotalPosts: len(u.ReturnPosts),
+	}, u.ReturnError
+}
@@ -0,0 +1,35 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/geisonbiazus/blog/internal/ui/web/lib"
+)
+
+type StaticHandler struct {
+	assets     *lib.StaticAssets
+	fileServer http.Handler
+}
+
+// NewStaticHandler serves the static files on paths relative to their
+// directory. The fingerprinted paths are cached by clients for good, while the
+// others are left to the regular Last-Modified revalidation.
+func NewStaticHandler(staticPath string, assets *lib.StaticAssets) *StaticHandler {
+	return &StaticHandler{
+		assets:     assets,
+		fileServer: http.FileServer(http.Dir(staticPath)),
+	}
+}
+
+func (h *StaticHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	filePath, immutable := h.assets.Resolve(r.URL.Path)
+
+	if immutable {
+		w.Header().Set("Cache-Control", lib.CacheImmutable)
+	}
+
+	fileReq := r.Clone(r.Context())
+	fileReq.URL.Path = filePath
+
+	h.fileServer.ServeHTTP(w, fileReq)
+}
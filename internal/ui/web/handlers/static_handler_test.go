@@ -0,0 +1,73 @@
+package handlers_test
+
+import (
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/geisonbiazus/blog/internal/ui/web/handlers"
+	"github.com/geisonbiazus/blog/internal/ui/web/lib"
+	"github.com/geisonbiazus/blog/internal/ui/web/test"
+	"github.com/geisonbiazus/blog/pkg/testhelper"
+	"github.com/stretchr/testify/assert"
+)
+
+type staticHandlerFixture struct {
+	assets  *lib.StaticAssets
+	handler http.Handler
+}
+
+func TestStaticHandler(t *testing.T) {
+	setup := func() *staticHandlerFixture {
+		staticPath := filepath.Join("..", "..", "..", "..", "web", "static")
+		assets := lib.NewStaticAssets("", staticPath)
+
+		return &staticHandlerFixture{
+			assets:  assets,
+			handler: handlers.NewStaticHandler(staticPath, assets),
+		}
+	}
+
+	t.Run("Given a fingerprinted path it serves the file to be cached for good", func(t *testing.T) {
+		f := setup()
+
+		fingerprinted := f.assets.Path("/styles.css")
+
+		res := test.DoGetRequest(f.handler, fingerprinted)
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Regexp(t, `^/styles\.[0-9a-f]{10}\.css$`, fingerprinted)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, "public, max-age=31536000, immutable", res.Header.Get("Cache-Control"))
+		assert.Contains(t, body, ".blog-container")
+	})
+
+	t.Run("Given an outdated fingerprint it serves the current file without caching it for good", func(t *testing.T) {
+		f := setup()
+
+		res := test.DoGetRequest(f.handler, "/styles.0123456789.css")
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Empty(t, res.Header.Get("Cache-Control"))
+		assert.Contains(t, body, ".blog-container")
+	})
+
+	t.Run("Given a plain path it serves the file as is", func(t *testing.T) {
+		f := setup()
+
+		res := test.DoGetRequest(f.handler, "/styles.css")
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Empty(t, res.Header.Get("Cache-Control"))
+		assert.NotEmpty(t, res.Header.Get("Last-Modified"))
+	})
+
+	t.Run("Given an unknown file it returns 404", func(t *testing.T) {
+		f := setup()
+
+		res := test.DoGetRequest(f.handler, "/unknown.0123456789.css")
+
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+	})
+}
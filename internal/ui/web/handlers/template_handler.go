@@ -17,5 +17,5 @@ func NewTemplateHandler(renderer *lib.TemplateRenderer, templateName string) *Te
 
 func (h *TemplateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
-	h.template.Render(w, h.templateName, nil)
+	h.template.Render(w, r, h.templateName, nil)
 }
@@ -2,9 +2,12 @@ package handlers_test
 
 import (
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/geisonbiazus/blog/internal/core/auth"
 	"github.com/geisonbiazus/blog/internal/ui/web/handlers"
+	"github.com/geisonbiazus/blog/internal/ui/web/lib"
 	"github.com/geisonbiazus/blog/internal/ui/web/test"
 	"github.com/geisonbiazus/blog/pkg/testhelper"
 	"github.com/stretchr/testify/assert"
@@ -22,4 +25,41 @@ func TestTemplateHandler(t *testing.T) {
 		assert.Contains(t, body, "Geison Biazus")
 		assert.Contains(t, body, "Hello")
 	})
+
+	t.Run("It renders the login link when there is no current user", func(t *testing.T) {
+		templateRenderer := test.NewTestTemplateRenderer()
+		handler := handlers.NewTemplateHandler(templateRenderer, "about.html")
+
+		res := test.DoGetRequest(handler, "/")
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Contains(t, body, `href="/login/github"`)
+		assert.NotContains(t, body, "current-user")
+	})
+
+	t.Run("It renders the current user when there is one", func(t *testing.T) {
+		templateRenderer := test.NewTestTemplateRenderer()
+		handler := handlers.NewTemplateHandler(templateRenderer, "about.html")
+		user := auth.User{ID: "USER_ID", Name: "User Name", AvatarURL: "https://example.com/avatar.png"}
+
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		res := test.DoRequest(handler, req.WithContext(lib.WithCurrentUser(req.Context(), user)))
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Contains(t, body, "User Name")
+		assert.Contains(t, body, "https://example.com/avatar.png")
+		assert.NotContains(t, body, `href="/login/github"`)
+	})
+	t.Run("It listens to the reload events when live reload is enabled", func(t *testing.T) {
+		templateRenderer := test.NewTestTemplateRenderer()
+		handler := handlers.NewTemplateHandler(templateRenderer, "about.html")
+
+		body := testhelper.ReadResponseBody(test.DoGetRequest(handler, "/"))
+		assert.NotContains(t, body, "/_reload")
+
+		templateRenderer.EnableLiveReload()
+
+		body = testhelper.ReadResponseBody(test.DoGetRequest(handler, "/"))
+		assert.Contains(t, body, "new EventSource('/_reload')")
+	})
 }
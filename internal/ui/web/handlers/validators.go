@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/geisonbiazus/blog/internal/core/blog"
+	"github.com/geisonbiazus/blog/internal/core/discussion"
+)
+
+// latestPostChange returns the last time any of the posts changed, or the
+// given time when it's later.
+func latestPostChange(since time.Time, posts ...blog.Post) time.Time {
+	for _, post := range posts {
+		since = latestTime(since, post.LastModified())
+	}
+
+	return since
+}
+
+// latestCommentChange goes through the replies too. Comments deleted for good
+// leave no trace, so only the ETag notices them.
+func latestCommentChange(since time.Time, comments []*discussion.Comment) time.Time {
+	for _, comment := range comments {
+		since = latestTime(since, comment.CreatedAt, comment.UpdatedAt, comment.DeletedAt)
+		since = latestCommentChange(since, comment.Replies)
+	}
+
+	return since
+}
+
+func latestTime(since time.Time, times ...time.Time) time.Time {
+	for _, t := range times {
+		if t.After(since) {
+			since = t
+		}
+	}
+
+	return since
+}
+
+// postVersions identifies the posts by the hash computed when they were
+// loaded, so the validators don't go through their content on every request.
+func postVersions(posts ...blog.Post) string {
+	var versions strings.Builder
+
+	for _, post := range posts {
+		fmt.Fprintf(&versions, "%s:%s:%d;", post.Path, post.Metadata.Hash, post.LastModified().UnixNano())
+	}
+
+	return versions.String()
+}
+
+// renderedPostVersions uses the hash computed when the posts were rendered.
+func renderedPostVersions(posts ...blog.RenderedPost) string {
+	var versions strings.Builder
+
+	for _, post := range posts {
+		fmt.Fprintf(&versions, "%s:%s:%d;", post.Post.Path, post.Hash, post.Post.LastModified().UnixNano())
+	}
+
+	return versions.String()
+}
+
+// commentVersions identifies the comments by what changes when they're
+// edited, moderated or deleted, and by the current name and avatar of their
+// authors, which the comments don't keep.
+func commentVersions(comments []*discussion.Comment) string {
+	var versions strings.Builder
+	writeCommentVersions(&versions, comments)
+	return versions.String()
+}
+
+func writeCommentVersions(versions *strings.Builder, comments []*discussion.Comment) {
+	for _, comment := range comments {
+		fmt.Fprintf(versions, "%s:%s:%t:%d:%d", comment.ID, comment.Status, comment.Hidden, comment.UpdatedAt.UnixNano(), comment.DeletedAt.UnixNano())
+
+		if comment.Author != nil {
+			fmt.Fprintf(versions, ":%q:%q", comment.Author.Name, comment.Author.AvatarURL)
+		}
+
+		versions.WriteString("[")
+		writeCommentVersions(versions, comment.Replies)
+		versions.WriteString("];")
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"html/template"
 	"net/http"
 	"path"
+	"time"
 
 	"github.com/geisonbiazus/blog/internal/core/blog"
 	"github.com/geisonbiazus/blog/internal/core/discussion"
@@ -14,85 +15,168 @@ import (
 
 type ViewPostHandler struct {
 	viewPostUseCase     ports.ViewPostUseCase
+	viewSeriesUseCase   ports.ViewSeriesUseCase
+	relatedPostsUseCase ports.RelatedPostsUseCase
 	listCommentsUseCase ports.ListCommentsUseCase
 	template            *lib.TemplateRenderer
 }
 
 func NewViewPostHandler(
 	viewPostUseCase ports.ViewPostUseCase,
+	viewSeriesUseCase ports.ViewSeriesUseCase,
+	relatedPostsUseCase ports.RelatedPostsUseCase,
 	listCommentsUseCase ports.ListCommentsUseCase,
 	templateRenderer *lib.TemplateRenderer,
 ) *ViewPostHandler {
 	return &ViewPostHandler{
 		viewPostUseCase:     viewPostUseCase,
+		viewSeriesUseCase:   viewSeriesUseCase,
+		relatedPostsUseCase: relatedPostsUseCase,
 		listCommentsUseCase: listCommentsUseCase,
 		template:            templateRenderer,
 	}
 }
 
+const relatedPostsLimit = 3
+
 func (h *ViewPostHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	path := path.Base(r.URL.Path)
 
 	renderedPost, err := h.viewPostUseCase.Run(path)
 	if err != nil {
-		h.handleViewPostError(w, err)
+		h.handleViewPostError(w, r, err)
+		return
+	}
+
+	series, err := h.findSeries(renderedPost.Post)
+	if err != nil {
+		h.respondWithInternalServerError(w, r)
 		return
 	}
 
-	comments, err := h.listCommentsUseCase.Run(r.Context(), path)
+	related, err := h.relatedPostsUseCase.Run(path, relatedPostsLimit)
+	if err != nil {
+		h.respondWithInternalServerError(w, r)
+		return
+	}
+
+	user, _ := lib.CurrentUser(r.Context())
+
+	comments, err := h.listCommentsUseCase.Run(r.Context(), path, user.ID)
 	if err != nil {
-		h.respondWithInternalServerError(w)
+		h.respondWithInternalServerError(w, r)
 		return
 	}
 
+	validators := lib.NewValidators(
+		time.Time{}, h.template.Version(), user.ID, string(user.Role), lib.CSRFToken(r),
+		renderedPost.Hash, postVersions(series.Posts...), postVersions(related...), commentVersions(comments),
+	)
+
+	if lib.NotModified(w, r, lib.CachePage, validators) {
+		return
+	}
+
+	viewModel := h.toViewModel(r, renderedPost, comments)
+	viewModel.Series = toSeriesNavigationViewModel(series, renderedPost.Post.Path)
+	viewModel.Related = toPostLinksViewModel(related)
+
 	w.WriteHeader(http.StatusOK)
-	h.template.Render(w, "view_post.html", h.toViewModel(renderedPost, comments))
+	h.template.Render(w, r, "view_post.html", viewModel)
 }
 
-func (h *ViewPostHandler) handleViewPostError(w http.ResponseWriter, err error) {
+func (h *ViewPostHandler) findSeries(post blog.Post) (blog.Series, error) {
+	if post.Series == "" {
+		return blog.Series{}, nil
+	}
+
+	return h.viewSeriesUseCase.Run(post.SeriesSlug())
+}
+
+func (h *ViewPostHandler) handleViewPostError(w http.ResponseWriter, r *http.Request, err error) {
 	if err == blog.ErrPostNotFound {
-		h.respondWithNotFound(w)
+		h.respondWithNotFound(w, r)
 	} else {
-		h.respondWithInternalServerError(w)
+		h.respondWithInternalServerError(w, r)
 	}
 }
 
-func (h *ViewPostHandler) respondWithNotFound(w http.ResponseWriter) {
+func (h *ViewPostHandler) respondWithNotFound(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotFound)
-	h.template.Render(w, "404.html", nil)
+	h.template.Render(w, r, "404.html", nil)
 }
 
-func (h *ViewPostHandler) respondWithInternalServerError(w http.ResponseWriter) {
+func (h *ViewPostHandler) respondWithInternalServerError(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusInternalServerError)
-	h.template.Render(w, "500.html", nil)
+	h.template.Render(w, r, "500.html", nil)
 }
 
-func (h *ViewPostHandler) toViewModel(p blog.RenderedPost, comments []*discussion.Comment) postViewModel {
+func (h *ViewPostHandler) toViewModel(r *http.Request, p blog.RenderedPost, comments []*discussion.Comment) postViewModel {
+	user, _ := lib.CurrentUser(r.Context())
+
+	viewModel := toPostViewModel(p)
+	viewModel.Comments = h.toCommentsViewModel(viewModel.Path, user.ID, comments)
+	viewModel.CommentForm = commentFormViewModel{PostPath: viewModel.Path, SubjectID: p.Post.Path}
+
+	return viewModel
+}
+
+func toPostViewModel(p blog.RenderedPost) postViewModel {
 	return postViewModel{
 		Title:       p.Post.Title,
 		Author:      p.Post.Author,
 		Description: p.Post.Description,
+		Summary:     p.Post.Summary(),
+		WordCount:   p.Post.Metadata.WordCount,
+		ReadingTime: formatReadingTime(p.Post.Metadata.ReadingTime),
 		ImagePath:   p.Post.ImagePath,
 		Path:        fmt.Sprintf("/posts/%s", p.Post.Path),
 		Date:        p.Post.Time.Format(lib.DateFormat),
 		Content:     template.HTML(p.HTML),
-		Comments:    h.toCommentsViewModel(comments),
+		Tags:        toTagsViewModel(p.Post.Tags),
+		Params:      p.Post.Params,
+		TOC:         toTOCViewModel(p),
+	}
+}
+
+func formatReadingTime(readingTime time.Duration) string {
+	if readingTime == 0 {
+		return ""
 	}
+
+	return fmt.Sprintf("%d min read", int(readingTime.Minutes()))
 }
 
-func (h *ViewPostHandler) toCommentsViewModel(comments []*discussion.Comment) []commentViewModel {
+func toTOCViewModel(p blog.RenderedPost) []tocEntryViewModel {
+	if !p.Post.TOC {
+		return nil
+	}
+
+	return toTOCEntriesViewModel(p.Headings)
+}
+
+func toTOCEntriesViewModel(headings []blog.Heading) []tocEntryViewModel {
+	var result []tocEntryViewModel
+
+	for _, heading := range headings {
+		result = append(result, tocEntryViewModel{
+			Text:    heading.Text,
+			Path:    "#" + heading.ID,
+			Entries: toTOCEntriesViewModel(heading.Children),
+		})
+	}
+
+	return result
+}
+
+func (h *ViewPostHandler) toCommentsViewModel(postPath, currentUserID string, comments []*discussion.Comment) []commentViewModel {
 	result := []commentViewModel{}
 
 	for _, comment := range comments {
-		viewModel := commentViewModel{
-			AuthorAvatarURL: comment.Author.AvatarURL,
-			AuthorName:      comment.Author.Name,
-			Date:            comment.CreatedAt.Format(lib.DateFormat),
-			Content:         template.HTML(comment.HTML),
-		}
+		viewModel := h.toCommentViewModel(postPath, currentUserID, comment)
 
 		if comment.Replies != nil {
-			viewModel.Replies = h.toCommentsViewModel(comment.Replies)
+			viewModel.Replies = h.toCommentsViewModel(postPath, currentUserID, comment.Replies)
 		}
 
 		result = append(result, viewModel)
@@ -101,21 +185,145 @@ func (h *ViewPostHandler) toCommentsViewModel(comments []*discussion.Comment) []
 	return result
 }
 
+func (h *ViewPostHandler) toCommentViewModel(postPath, currentUserID string, comment *discussion.Comment) commentViewModel {
+	if comment.IsDeleted() {
+		return toPlaceholderCommentViewModel(comment, deletedCommentPlaceholder)
+	}
+
+	if comment.Hidden {
+		return toPlaceholderCommentViewModel(comment, hiddenCommentPlaceholder)
+	}
+
+	return commentViewModel{
+		ID:              comment.ID,
+		AuthorAvatarURL: comment.Author.AvatarURL,
+		AuthorName:      comment.Author.Name,
+		Date:            comment.CreatedAt.Format(lib.DateFormat),
+		Content:         template.HTML(comment.HTML),
+		Markdown:        comment.Markdown,
+		PostPath:        postPath,
+		Edited:          comment.IsEdited(),
+		Pending:         comment.IsPending(),
+		CanEdit:         currentUserID != "" && comment.Author.UserID == currentUserID,
+		ReplyForm:       commentFormViewModel{PostPath: postPath, SubjectID: comment.ID},
+	}
+}
+
+// toPlaceholderCommentViewModel stands for a comment that is no longer shown
+// but still has replies.
+func toPlaceholderCommentViewModel(comment *discussion.Comment, placeholder string) commentViewModel {
+	return commentViewModel{
+		ID:          comment.ID,
+		AuthorName:  placeholder,
+		Date:        comment.CreatedAt.Format(lib.DateFormat),
+		Content:     template.HTML(placeholder),
+		Placeholder: true,
+	}
+}
+
+const deletedCommentPlaceholder = "[deleted]"
+const hiddenCommentPlaceholder = "[hidden]"
+
 type postViewModel struct {
 	Title       string
 	Author      string
 	Date        string
 	Description string
+	Summary     string
+	WordCount   int
+	ReadingTime string
 	ImagePath   string
 	Path        string
 	Content     template.HTML
+	Tags        []tagViewModel
+	Params      map[string]interface{}
+	TOC         []tocEntryViewModel
+	Series      *seriesNavigationViewModel
+	Related     []*postLinkViewModel
+	Preview     bool
 	Comments    []commentViewModel
+	CommentForm commentFormViewModel
+}
+
+type tocEntryViewModel struct {
+	Text    string
+	Path    string
+	Entries []tocEntryViewModel
 }
 
 type commentViewModel struct {
+	ID              string
 	AuthorAvatarURL string
 	AuthorName      string
 	Date            string
 	Content         template.HTML
+	Markdown        string
+	PostPath        string
+	Edited          bool
+	Pending         bool
+	Placeholder     bool
+	CanEdit         bool
 	Replies         []commentViewModel
+	ReplyForm       commentFormViewModel
+}
+
+type commentFormViewModel struct {
+	PostPath  string
+	SubjectID string
+}
+
+type seriesNavigationViewModel struct {
+	Title    string
+	Path     string
+	Part     int
+	Total    int
+	Previous *postLinkViewModel
+	Next     *postLinkViewModel
+}
+
+type postLinkViewModel struct {
+	Title string
+	Path  string
+}
+
+func toSeriesNavigationViewModel(series blog.Series, postPath string) *seriesNavigationViewModel {
+	part := series.Part(postPath)
+
+	if part == 0 {
+		return nil
+	}
+
+	viewModel := &seriesNavigationViewModel{
+		Title: series.Title,
+		Path:  seriesPath(series.Slug),
+		Part:  part,
+		Total: len(series.Posts),
+	}
+
+	if previous, ok := series.Previous(postPath); ok {
+		viewModel.Previous = toPostLinkViewModel(previous)
+	}
+
+	if next, ok := series.Next(postPath); ok {
+		viewModel.Next = toPostLinkViewModel(next)
+	}
+
+	return viewModel
+}
+
+func toPostLinksViewModel(posts []blog.Post) []*postLinkViewModel {
+	result := []*postLinkViewModel{}
+
+	for _, post := range posts {
+		result = append(result, toPostLinkViewModel(post))
+	}
+
+	return result
+}
+
+func toPostLinkViewModel(post blog.Post) *postLinkViewModel {
+	return &postLinkViewModel{
+		Title: post.Title,
+		Path:  fmt.Sprintf("/posts/%s", post.Path),
+	}
 }
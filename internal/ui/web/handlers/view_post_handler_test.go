@@ -4,10 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/geisonbiazus/blog/internal/core/auth"
 	"github.com/geisonbiazus/blog/internal/core/blog"
 	"github.com/geisonbiazus/blog/internal/core/discussion"
 	"github.com/geisonbiazus/blog/internal/ui/web/handlers"
@@ -19,6 +23,8 @@ import (
 
 type viewPostHandlerFixture struct {
 	viewPostUseCase     *viewPostUseCaseSpy
+	viewSeriesUseCase   *viewSeriesUseCaseSpy
+	relatedPostsUseCase *relatedPostsUseCaseSpy
 	listCommentsUseCase *listCommentsUseCaseSpy
 	handler             http.Handler
 }
@@ -26,12 +32,16 @@ type viewPostHandlerFixture struct {
 func TestViewPostHandler(t *testing.T) {
 	setup := func() *viewPostHandlerFixture {
 		viewPostUseCase := &viewPostUseCaseSpy{}
+		viewSeriesUseCase := &viewSeriesUseCaseSpy{}
+		relatedPostsUseCase := &relatedPostsUseCaseSpy{}
 		listCommentsUseCase := &listCommentsUseCaseSpy{}
 		templateRenderer := test.NewTestTemplateRenderer()
-		handler := handlers.NewViewPostHandler(viewPostUseCase, listCommentsUseCase, templateRenderer)
+		handler := handlers.NewViewPostHandler(viewPostUseCase, viewSeriesUseCase, relatedPostsUseCase, listCommentsUseCase, templateRenderer)
 
 		return &viewPostHandlerFixture{
 			viewPostUseCase:     viewPostUseCase,
+			viewSeriesUseCase:   viewSeriesUseCase,
+			relatedPostsUseCase: relatedPostsUseCase,
 			listCommentsUseCase: listCommentsUseCase,
 			handler:             handler,
 		}
@@ -51,6 +61,174 @@ func TestViewPostHandler(t *testing.T) {
 		assertContainsRenderedPost(t, body, renderedPost)
 	})
 
+	t.Run("It renders the reading time and the word count of the post", func(t *testing.T) {
+		f := setup()
+
+		renderedPost := buildRenderedPost()
+		renderedPost.Post.Metadata = blog.PostMetadata{WordCount: 420, ReadingTime: 3 * time.Minute}
+		f.viewPostUseCase.ReturnPost = renderedPost
+
+		res := test.DoGetRequest(f.handler, "/posts/post-path")
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Contains(t, body, "3 min read · 420 words")
+		assert.Contains(t, body, `<meta property="og:description" content="post description" />`)
+	})
+
+	t.Run("Given a post without description it uses the excerpt as og:description", func(t *testing.T) {
+		f := setup()
+
+		renderedPost := buildRenderedPost()
+		renderedPost.Post.Description = ""
+		renderedPost.Post.Metadata = blog.PostMetadata{Excerpt: "The first paragraph"}
+		f.viewPostUseCase.ReturnPost = renderedPost
+
+		res := test.DoGetRequest(f.handler, "/posts/post-path")
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Contains(t, body, `<meta property="og:description" content="The first paragraph" />`)
+	})
+
+	t.Run("Given a post with params they are available to the template", func(t *testing.T) {
+		f := setup()
+
+		renderedPost := buildRenderedPost()
+		renderedPost.Post.Params = map[string]interface{}{"canonical_url": "https://example.com/original"}
+		f.viewPostUseCase.ReturnPost = renderedPost
+
+		res := test.DoGetRequest(f.handler, "/posts/post-path")
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Contains(t, body, `<link rel="canonical" href="https://example.com/original" />`)
+	})
+
+	t.Run("Given a post with table of contents it renders the headings as links", func(t *testing.T) {
+		f := setup()
+
+		renderedPost := buildRenderedPost()
+		renderedPost.Post.TOC = true
+		renderedPost.Headings = []blog.Heading{
+			{Level: 2, Text: "Introduction", ID: "introduction", Children: []blog.Heading{
+				{Level: 3, Text: "Motivation", ID: "motivation"},
+			}},
+			{Level: 2, Text: "Conclusion", ID: "conclusion"},
+		}
+		f.viewPostUseCase.ReturnPost = renderedPost
+
+		res := test.DoGetRequest(f.handler, "/posts/post-path")
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Contains(t, body, `aria-label="Table of contents"`)
+		assert.Contains(t, body, `<a class="link-secondary" href="#introduction">Introduction</a>`)
+		assert.Contains(t, body, `<a class="link-secondary" href="#motivation">Motivation</a>`)
+		assert.Contains(t, body, `<a class="link-secondary" href="#conclusion">Conclusion</a>`)
+	})
+
+	t.Run("Given a post without table of contents it doesn't render it", func(t *testing.T) {
+		f := setup()
+
+		renderedPost := buildRenderedPost()
+		renderedPost.Headings = []blog.Heading{{Level: 2, Text: "Introduction", ID: "introduction"}}
+		f.viewPostUseCase.ReturnPost = renderedPost
+
+		res := test.DoGetRequest(f.handler, "/posts/post-path")
+		body := testhelper.ReadResponseBody(res)
+
+		assert.NotContains(t, body, "Table of contents")
+	})
+
+	t.Run("Given a post in a series it renders the series navigation", func(t *testing.T) {
+		f := setup()
+
+		renderedPost := buildRenderedPost()
+		renderedPost.Post.Series = "Data Structures"
+		f.viewPostUseCase.ReturnPost = renderedPost
+		f.viewSeriesUseCase.ReturnSeries = blog.Series{
+			Slug:  "data-structures",
+			Title: "Data Structures",
+			Posts: []blog.Post{
+				{Path: "part-1", Title: "Part One"},
+				renderedPost.Post,
+				{Path: "part-3", Title: "Part Three"},
+			},
+		}
+
+		res := test.DoGetRequest(f.handler, "/posts/post-path")
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, "data-structures", f.viewSeriesUseCase.ReceivedSlug)
+		assert.Contains(t, body, "Part 2 of 3")
+		assert.Contains(t, body, `href="/series/data-structures"`)
+		assert.Contains(t, body, `rel="prev" href="/posts/part-1"`)
+		assert.Contains(t, body, `rel="next" href="/posts/part-3"`)
+	})
+
+	t.Run("Given a post outside a series it doesn't render the series navigation", func(t *testing.T) {
+		f := setup()
+
+		f.viewPostUseCase.ReturnPost = buildRenderedPost()
+
+		res := test.DoGetRequest(f.handler, "/posts/post-path")
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, "", f.viewSeriesUseCase.ReceivedSlug)
+		assert.NotContains(t, body, "series-navigation")
+	})
+
+	t.Run("Given an error is returned when loading the series it responds with server error", func(t *testing.T) {
+		f := setup()
+
+		renderedPost := buildRenderedPost()
+		renderedPost.Post.Series = "Data Structures"
+		f.viewPostUseCase.ReturnPost = renderedPost
+		f.viewSeriesUseCase.ReturnError = errors.New("any error")
+
+		res := test.DoGetRequest(f.handler, "/posts/post-path")
+
+		assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+	})
+
+	t.Run("Given related posts it renders links to them", func(t *testing.T) {
+		f := setup()
+
+		f.viewPostUseCase.ReturnPost = buildRenderedPost()
+		f.relatedPostsUseCase.ReturnPosts = []blog.Post{{Path: "related-1", Title: "Related One"}, {Path: "related-2", Title: "Related Two"}}
+
+		res := test.DoGetRequest(f.handler, "/posts/post-path")
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, "post-path", f.relatedPostsUseCase.ReceivedPath)
+		assert.Equal(t, 3, f.relatedPostsUseCase.ReceivedLimit)
+		assert.Contains(t, body, "You might also like")
+		assert.Contains(t, body, `<a class="link-secondary" href="/posts/related-1">Related One</a>`)
+		assert.Contains(t, body, `<a class="link-secondary" href="/posts/related-2">Related Two</a>`)
+	})
+
+	t.Run("Given no related posts it doesn't render the section", func(t *testing.T) {
+		f := setup()
+
+		f.viewPostUseCase.ReturnPost = buildRenderedPost()
+
+		res := test.DoGetRequest(f.handler, "/posts/post-path")
+		body := testhelper.ReadResponseBody(res)
+
+		assert.NotContains(t, body, "You might also like")
+	})
+
+	t.Run("Given an error is returned when loading related posts it responds with server error", func(t *testing.T) {
+		f := setup()
+
+		f.viewPostUseCase.ReturnPost = buildRenderedPost()
+		f.relatedPostsUseCase.ReturnError = errors.New("any error")
+
+		res := test.DoGetRequest(f.handler, "/posts/post-path")
+
+		assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+	})
+
 	t.Run("Given a post with comments it renders the comments and replies", func(t *testing.T) {
 		f := setup()
 
@@ -80,6 +258,104 @@ func TestViewPostHandler(t *testing.T) {
 		assert.NotContains(t, body, "Comments")
 	})
 
+	t.Run("Given an edited comment it marks it as edited", func(t *testing.T) {
+		f := setup()
+
+		comments := buildComments()
+		comments[0].UpdatedAt = comments[0].CreatedAt.Add(time.Hour)
+		f.viewPostUseCase.ReturnPost = buildRenderedPost()
+		f.listCommentsUseCase.ReturnComments = comments
+
+		res := test.DoGetRequest(f.handler, "/posts/post-path")
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Contains(t, body, "(edited)")
+	})
+
+	t.Run("Given a deleted comment it renders a placeholder keeping the replies", func(t *testing.T) {
+		f := setup()
+
+		comments := buildComments()
+		comments[0].DeletedAt = comments[0].CreatedAt.Add(time.Hour)
+		f.viewPostUseCase.ReturnPost = buildRenderedPost()
+		f.listCommentsUseCase.ReturnComments = comments
+
+		res := test.DoGetRequest(f.handler, "/posts/post-path")
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Contains(t, body, "[deleted]")
+		assert.NotContains(t, body, comments[0].Author.Name)
+		assert.Contains(t, body, comments[0].Replies[0].Author.Name)
+	})
+
+	t.Run("Given a hidden comment it renders a placeholder keeping the replies", func(t *testing.T) {
+		f := setup()
+
+		comments := buildComments()
+		authorName := comments[0].Author.Name
+		comments[0] = &discussion.Comment{
+			ID:        comments[0].ID,
+			CreatedAt: comments[0].CreatedAt,
+			Hidden:    true,
+			Replies:   comments[0].Replies,
+		}
+		f.viewPostUseCase.ReturnPost = buildRenderedPost()
+		f.listCommentsUseCase.ReturnComments = comments
+
+		res := test.DoGetRequest(f.handler, "/posts/post-path")
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Contains(t, body, "[hidden]")
+		assert.NotContains(t, body, authorName)
+		assert.Contains(t, body, comments[0].Replies[0].Author.Name)
+	})
+
+	t.Run("Given the current user owns a comment it renders the edit and delete forms", func(t *testing.T) {
+		f := setup()
+
+		comments := buildComments()
+		f.viewPostUseCase.ReturnPost = buildRenderedPost()
+		f.listCommentsUseCase.ReturnComments = comments
+
+		req := httptest.NewRequest(http.MethodGet, "/posts/post-path", nil)
+		user := auth.User{ID: comments[0].Author.UserID}
+		res := test.DoRequest(f.handler, req.WithContext(lib.WithCurrentUser(req.Context(), user)))
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, 1, strings.Count(body, `action="/comments/edit"`))
+		assert.Equal(t, 1, strings.Count(body, `action="/comments/delete"`))
+	})
+
+	t.Run("Given a pending comment of the current user it lists it marked as awaiting moderation", func(t *testing.T) {
+		f := setup()
+
+		comments := buildComments()
+		comments[0].Status = discussion.CommentPending
+		f.viewPostUseCase.ReturnPost = buildRenderedPost()
+		f.listCommentsUseCase.ReturnComments = comments
+
+		req := httptest.NewRequest(http.MethodGet, "/posts/post-path", nil)
+		user := auth.User{ID: comments[0].Author.UserID}
+		res := test.DoRequest(f.handler, req.WithContext(lib.WithCurrentUser(req.Context(), user)))
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, user.ID, f.listCommentsUseCase.ReceivedViewerUserID)
+		assert.Equal(t, 1, strings.Count(body, "Awaiting moderation"))
+	})
+
+	t.Run("Given an anonymous visitor it doesn't render the edit and delete forms", func(t *testing.T) {
+		f := setup()
+
+		f.viewPostUseCase.ReturnPost = buildRenderedPost()
+		f.listCommentsUseCase.ReturnComments = buildComments()
+
+		res := test.DoGetRequest(f.handler, "/posts/post-path")
+		body := testhelper.ReadResponseBody(res)
+
+		assert.NotContains(t, body, `action="/comments/edit"`)
+		assert.NotContains(t, body, `action="/comments/delete"`)
+	})
+
 	t.Run("Given an error is returned when loading coments it responds with server error", func(t *testing.T) {
 		f := setup()
 
@@ -119,6 +395,119 @@ func TestViewPostHandler(t *testing.T) {
 		assert.Equal(t, "post-path", f.viewPostUseCase.ReceivedPath)
 		assert.True(t, strings.Contains(body, "Internal server error"))
 	})
+
+	t.Run("It sets the validators from the post and its comments", func(t *testing.T) {
+		f := setup()
+
+		f.viewPostUseCase.ReturnPost = buildRenderedPost()
+		f.listCommentsUseCase.ReturnComments = buildComments()
+		f.listCommentsUseCase.ReturnComments[0].UpdatedAt = testhelper.ParseTime("2022-10-05T08:30:00Z")
+
+		res := test.DoGetRequest(f.handler, "/posts/post-path")
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.NotEmpty(t, res.Header.Get("ETag"))
+		assert.Empty(t, res.Header.Get("Last-Modified"))
+		assert.Equal(t, "private, no-cache", res.Header.Get("Cache-Control"))
+	})
+
+	t.Run("Given the client has the current version it responds with not modified", func(t *testing.T) {
+		f := setup()
+
+		f.viewPostUseCase.ReturnPost = buildRenderedPost()
+		f.listCommentsUseCase.ReturnComments = buildComments()
+
+		header := test.DoGetRequest(f.handler, "/posts/post-path").Header
+		res := test.DoConditionalGetRequest(f.handler, "/posts/post-path", "If-None-Match", header.Get("ETag"))
+
+		assert.Equal(t, http.StatusNotModified, res.StatusCode)
+		assert.Empty(t, testhelper.ReadResponseBody(res))
+	})
+
+	t.Run("Given the client sends a date it responds with the post", func(t *testing.T) {
+		f := setup()
+
+		f.viewPostUseCase.ReturnPost = buildRenderedPost()
+		f.listCommentsUseCase.ReturnComments = buildComments()
+
+		res := test.DoConditionalGetRequest(f.handler, "/posts/post-path", "If-Modified-Since", "Wed, 05 Oct 2050 08:30:00 GMT")
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+	})
+
+	t.Run("Given the role of the user changed it responds with the post again", func(t *testing.T) {
+		f := setup()
+
+		f.viewPostUseCase.ReturnPost = buildRenderedPost()
+		f.listCommentsUseCase.ReturnComments = buildComments()
+
+		req := httptest.NewRequest(http.MethodGet, "/posts/post-path", nil)
+		user := auth.User{ID: "USER_ID", Role: auth.RoleCommenter}
+		etag := test.DoRequest(f.handler, req.WithContext(lib.WithCurrentUser(req.Context(), user))).Header.Get("ETag")
+
+		user.Role = auth.RoleModerator
+		req = httptest.NewRequest(http.MethodGet, "/posts/post-path", nil)
+		req.Header.Set("If-None-Match", etag)
+		res := test.DoRequest(f.handler, req.WithContext(lib.WithCurrentUser(req.Context(), user)))
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.NotEqual(t, etag, res.Header.Get("ETag"))
+	})
+
+	t.Run("Given params that can't be encoded as JSON it still sets the validators", func(t *testing.T) {
+		f := setup()
+
+		renderedPost := buildRenderedPost()
+		renderedPost.Post.Params = map[string]interface{}{"ratio": math.NaN()}
+		f.viewPostUseCase.ReturnPost = renderedPost
+
+		header := test.DoGetRequest(f.handler, "/posts/post-path").Header
+		res := test.DoConditionalGetRequest(f.handler, "/posts/post-path", "If-None-Match", header.Get("ETag"))
+
+		assert.NotEmpty(t, header.Get("ETag"))
+		assert.Equal(t, http.StatusNotModified, res.StatusCode)
+	})
+
+	t.Run("Given the comments changed it responds with the post again", func(t *testing.T) {
+		f := setup()
+
+		f.viewPostUseCase.ReturnPost = buildRenderedPost()
+		f.listCommentsUseCase.ReturnComments = buildComments()
+
+		etag := test.DoGetRequest(f.handler, "/posts/post-path").Header.Get("ETag")
+		f.listCommentsUseCase.ReturnComments[0].HTML = "<p>Edited comment</p>"
+		f.listCommentsUseCase.ReturnComments[0].UpdatedAt = testhelper.ParseTime("2022-10-05T08:30:00Z")
+		res := test.DoConditionalGetRequest(f.handler, "/posts/post-path", "If-None-Match", etag)
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.NotEqual(t, etag, res.Header.Get("ETag"))
+		assert.Contains(t, testhelper.ReadResponseBody(res), "Edited comment")
+	})
+
+	t.Run("Given the post was rendered again it responds with the post again", func(t *testing.T) {
+		f := setup()
+
+		f.viewPostUseCase.ReturnPost = buildRenderedPost()
+		etag := test.DoGetRequest(f.handler, "/posts/post-path").Header.Get("ETag")
+		f.viewPostUseCase.ReturnPost.Hash = "new-hash"
+
+		res := test.DoConditionalGetRequest(f.handler, "/posts/post-path", "If-None-Match", etag)
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+	})
+
+	t.Run("Given a related post changed it responds with the post again", func(t *testing.T) {
+		f := setup()
+
+		f.viewPostUseCase.ReturnPost = buildRenderedPost()
+		f.relatedPostsUseCase.ReturnPosts = []blog.Post{{Title: "Related", Path: "related"}}
+		etag := test.DoGetRequest(f.handler, "/posts/post-path").Header.Get("ETag")
+		f.relatedPostsUseCase.ReturnPosts[0].Metadata.Hash = "new-hash"
+
+		res := test.DoConditionalGetRequest(f.handler, "/posts/post-path", "If-None-Match", etag)
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+	})
 }
 
 func buildRenderedPost() blog.RenderedPost {
@@ -129,9 +518,11 @@ func buildRenderedPost() blog.RenderedPost {
 			Path:        "post-path",
 			Description: "post description",
 			ImagePath:   "/static/image/post.png",
+			Tags:        []string{"go"},
 			Time:        testhelper.ParseTime("2021-04-03T00:00:00+00:00"),
 		},
 		HTML: "<p>Content<p>",
+		Hash: "post-hash",
 	}
 }
 
@@ -142,6 +533,7 @@ func buildComments() []*discussion.Comment {
 			SubjectID: "post-path",
 			Author: &discussion.Author{
 				ID:        "COMMENT_AUTHOR_ID",
+				UserID:    "COMMENT_USER_ID",
 				Name:      "Comment Author",
 				AvatarURL: "https://example.com/comment-author-avatar",
 			},
@@ -153,6 +545,7 @@ func buildComments() []*discussion.Comment {
 					SubjectID: "COMMENT_ID",
 					Author: &discussion.Author{
 						ID:        "REPLY_AUTHOR_ID",
+						UserID:    "REPLY_USER_ID",
 						Name:      "Reply Author",
 						AvatarURL: "https://example.com/reply-author-avatar",
 					},
@@ -172,6 +565,9 @@ func assertContainsRenderedPost(t *testing.T, body string, renderedPost blog.Ren
 	assert.Contains(t, body, fmt.Sprintf("http://example.com/posts/%s", renderedPost.Post.Path))
 	assert.Contains(t, body, renderedPost.Post.Time.Format(lib.DateFormat))
 	assert.Contains(t, body, renderedPost.HTML)
+	for _, tag := range renderedPost.Post.Tags {
+		assert.Contains(t, body, fmt.Sprintf(`href="/tags/%s"`, tag))
+	}
 }
 
 func assertContainsComments(t *testing.T, body string, comments []*discussion.Comment) {
@@ -198,15 +594,41 @@ func (u *viewPostUseCaseSpy) Run(path string) (blog.RenderedPost, error) {
 	return u.ReturnPost, u.ReturnError
 }
 
+type viewSeriesUseCaseSpy struct {
+	ReceivedSlug string
+	ReturnSeries blog.Series
+	ReturnError  error
+}
+
+func (u *viewSeriesUseCaseSpy) Run(slug string) (blog.Series, error) {
+	u.ReceivedSlug = slug
+	return u.ReturnSeries, u.ReturnError
+}
+
+type relatedPostsUseCaseSpy struct {
+	ReceivedPath  string
+	ReceivedLimit int
+	ReturnPosts   []blog.Post
+	ReturnError   error
+}
+
+func (u *relatedPostsUseCaseSpy) Run(path string, limit int) ([]blog.Post, error) {
+	u.ReceivedPath = path
+	u.ReceivedLimit = limit
+	return u.ReturnPosts, u.ReturnError
+}
+
 type listCommentsUseCaseSpy struct {
-	ReceivedCtx       context.Context
-	ReceivedSubjectID string
-	ReturnComments    []*discussion.Comment
-	ReturnError       error
+	ReceivedCtx          context.Context
+	ReceivedSubjectID    string
+	ReceivedViewerUserID string
+	ReturnComments       []*discussion.Comment
+	ReturnError          error
 }
 
-func (u *listCommentsUseCaseSpy) Run(ctx context.Context, subjectID string) ([]*discussion.Comment, error) {
+func (u *listCommentsUseCaseSpy) Run(ctx context.Context, subjectID, viewerUserID string) ([]*discussion.Comment, error) {
 	u.ReceivedCtx = ctx
 	u.ReceivedSubjectID = subjectID
+	u.ReceivedViewerUserID = viewerUserID
 	return u.ReturnComments, u.ReturnError
 }
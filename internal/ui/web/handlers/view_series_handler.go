@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+
+	"github.com/geisonbiazus/blog/internal/core/blog"
+	"github.com/geisonbiazus/blog/internal/ui/web/lib"
+	"github.com/geisonbiazus/blog/internal/ui/web/ports"
+)
+
+type ViewSeriesHandler struct {
+	usecase  ports.ViewSeriesUseCase
+	template *lib.TemplateRenderer
+}
+
+func NewViewSeriesHandler(usecase ports.ViewSeriesUseCase, templateRenderer *lib.TemplateRenderer) *ViewSeriesHandler {
+	return &ViewSeriesHandler{
+		usecase:  usecase,
+		template: templateRenderer,
+	}
+}
+
+func (h *ViewSeriesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	slug := path.Base(r.URL.Path)
+
+	series, err := h.usecase.Run(slug)
+
+	if err == blog.ErrSeriesNotFound {
+		w.WriteHeader(http.StatusNotFound)
+		h.template.Render(w, r, "404.html", nil)
+		return
+	}
+
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		h.template.Render(w, r, "500.html", nil)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	h.template.Render(w, r, "series.html", toSeriesViewModel(series))
+}
+
+type seriesViewModel struct {
+	Title    string
+	Path     string
+	FeedPath string
+	Posts    []seriesPostViewModel
+}
+
+type seriesPostViewModel struct {
+	Part  int
+	Title string
+	Path  string
+	Date  string
+}
+
+func toSeriesViewModel(series blog.Series) seriesViewModel {
+	posts := []seriesPostViewModel{}
+
+	for i, post := range series.Posts {
+		posts = append(posts, seriesPostViewModel{
+			Part:  i + 1,
+			Title: post.Title,
+			Path:  fmt.Sprintf("/posts/%s", post.Path),
+			Date:  post.Time.Format(lib.DateFormat),
+		})
+	}
+
+	return seriesViewModel{
+		Title:    series.Title,
+		Path:     seriesPath(series.Slug),
+		FeedPath: seriesPath(series.Slug) + "/feed.atom",
+		Posts:    posts,
+	}
+}
+
+func seriesPath(slug string) string {
+	return fmt.Sprintf("/series/%s", url.PathEscape(slug))
+}
@@ -0,0 +1,63 @@
+package handlers_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/geisonbiazus/blog/internal/core/blog"
+	"github.com/geisonbiazus/blog/internal/ui/web/handlers"
+	"github.com/geisonbiazus/blog/internal/ui/web/test"
+	"github.com/geisonbiazus/blog/pkg/testhelper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestViewSeriesHandler(t *testing.T) {
+	setup := func() (*handlers.ViewSeriesHandler, *viewSeriesUseCaseSpy) {
+		usecase := &viewSeriesUseCaseSpy{}
+		handler := handlers.NewViewSeriesHandler(usecase, test.NewTestTemplateRenderer())
+		return handler, usecase
+	}
+
+	t.Run("It renders the series with its posts in order", func(t *testing.T) {
+		handler, usecase := setup()
+		usecase.ReturnSeries = blog.Series{
+			Slug:  "data-structures",
+			Title: "Data Structures",
+			Posts: []blog.Post{renderedPost1.Post, renderedPost2.Post},
+		}
+
+		res := test.DoGetRequest(handler, "/series/data-structures")
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, "data-structures", usecase.ReceivedSlug)
+		assert.Contains(t, body, "Data Structures")
+		assert.Contains(t, body, "A series in 2 parts.")
+		assert.Contains(t, body, renderedPost1.Post.Title)
+		assert.Contains(t, body, renderedPost2.Post.Title)
+		assert.Contains(t, body, `href="/posts/`+renderedPost1.Post.Path+`"`)
+	})
+
+	t.Run("It responds with 404 when the series is not found", func(t *testing.T) {
+		handler, usecase := setup()
+		usecase.ReturnError = blog.ErrSeriesNotFound
+
+		res := test.DoGetRequest(handler, "/series/unknown")
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+		assert.Contains(t, body, "Page not found")
+	})
+
+	t.Run("It responds with server error when other error happens", func(t *testing.T) {
+		handler, usecase := setup()
+		usecase.ReturnError = errors.New("any error")
+
+		res := test.DoGetRequest(handler, "/series/data-structures")
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+		assert.Contains(t, body, "Internal server error")
+	})
+}
@@ -0,0 +1,100 @@
+package lib
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Cache policies for the Cache-Control header.
+const (
+	// CachePage makes clients revalidate the pages on every visit, which is
+	// cheap with the validators, as they change with comments and sessions.
+	// Being personal, they are kept out of shared caches. Pages have no
+	// Last-Modified since no date covers the session or deleted comments.
+	CachePage = "private, no-cache"
+	// CacheFeed lets feed readers and proxies keep the feeds for a while.
+	CacheFeed = "public, max-age=300"
+	// CacheImmutable is for fingerprinted URLs, whose content never changes.
+	CacheImmutable = "public, max-age=31536000, immutable"
+)
+
+// Validators identify a version of a response so clients can revalidate their
+// copy with a conditional request instead of downloading it again.
+type Validators struct {
+	ETag         string
+	LastModified time.Time
+}
+
+// NewValidators builds the ETag from a hash of the given parts, which must
+// identify everything the response is rendered from, e.g. the hashes and
+// update times of the content rather than the content itself. A zero
+// lastModified is left out of the response.
+func NewValidators(lastModified time.Time, parts ...string) Validators {
+	hash := sha256.New()
+
+	for _, part := range parts {
+		fmt.Fprintf(hash, "%d:%s;", len(part), part)
+	}
+
+	return Validators{
+		ETag:         `"` + hex.EncodeToString(hash.Sum(nil))[:32] + `"`,
+		LastModified: lastModified,
+	}
+}
+
+// NotModified sets the validators and the cache policy on the response and
+// tells whether the copy the client already has is up to date. In that case it
+// responds with 304 and nothing else must be written.
+func NotModified(w http.ResponseWriter, r *http.Request, cacheControl string, v Validators) bool {
+	w.Header().Set("Cache-Control", cacheControl)
+	w.Header().Set("ETag", v.ETag)
+
+	if !v.LastModified.IsZero() {
+		w.Header().Set("Last-Modified", v.LastModified.UTC().Format(http.TimeFormat))
+	}
+
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		return false
+	}
+
+	if !isNotModified(r, v) {
+		return false
+	}
+
+	w.WriteHeader(http.StatusNotModified)
+	return true
+}
+
+// isNotModified follows RFC 7232, where If-None-Match takes precedence over
+// If-Modified-Since when both are sent.
+func isNotModified(r *http.Request, v Validators) bool {
+	if ifNoneMatch := r.Header.Get("If-None-Match"); ifNoneMatch != "" {
+		return etagMatches(ifNoneMatch, v.ETag)
+	}
+
+	ifModifiedSince, err := http.ParseTime(r.Header.Get("If-Modified-Since"))
+
+	if err != nil || v.LastModified.IsZero() {
+		return false
+	}
+
+	return !v.LastModified.Truncate(time.Second).After(ifModifiedSince)
+}
+
+// etagMatches uses the weak comparison, ignoring the W/ prefix, as required
+// for If-None-Match.
+func etagMatches(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+
+		if candidate == "*" || candidate == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+
+	return false
+}
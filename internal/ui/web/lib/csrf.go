@@ -0,0 +1,45 @@
+package lib
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"net/http"
+)
+
+const (
+	CSRFFieldName  = "csrf_token"
+	CSRFHeaderName = "X-CSRF-Token"
+)
+
+// CSRFToken returns the token the forms of the session must send back. It's
+// derived from the session token, which other sites can't read, so it changes
+// on every login and needs no storage. It's empty without a session.
+func CSRFToken(r *http.Request) string {
+	cookie, err := r.Cookie(SessionCookieName)
+	if err != nil || cookie.Value == "" {
+		return ""
+	}
+
+	hash := sha256.Sum256([]byte("csrf:" + cookie.Value))
+	return hex.EncodeToString(hash[:])
+}
+
+// ValidCSRFToken tells whether the request carries the token of its session,
+// either as a form field or as a header.
+func ValidCSRFToken(r *http.Request) bool {
+	expected := CSRFToken(r)
+
+	received := r.Header.Get(CSRFHeaderName)
+	if received == "" {
+		received = r.PostFormValue(CSRFFieldName)
+	}
+
+	return expected != "" && subtle.ConstantTimeCompare([]byte(expected), []byte(received)) == 1
+}
+
+// IsSafeMethod tells whether the method only reads, which is the case of the
+// requests other sites are allowed to trigger.
+func IsSafeMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
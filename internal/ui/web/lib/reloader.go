@@ -0,0 +1,59 @@
+package lib
+
+import "sync"
+
+// Reloader notifies the open browser tabs that the content changed so they
+// can reload the page. The callbacks registered with OnReload run before the
+// tabs are notified, so caches are already invalidated when the page is
+// requested again.
+type Reloader struct {
+	mutex     sync.Mutex
+	callbacks []func()
+	clients   map[chan struct{}]bool
+}
+
+func NewReloader() *Reloader {
+	return &Reloader{clients: map[chan struct{}]bool{}}
+}
+
+func (r *Reloader) OnReload(callback func()) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.callbacks = append(r.callbacks, callback)
+}
+
+// Subscribe returns a channel that receives a value on the next reload and a
+// function to stop listening to it.
+func (r *Reloader) Subscribe() (<-chan struct{}, func()) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	client := make(chan struct{}, 1)
+	r.clients[client] = true
+
+	return client, func() { r.unsubscribe(client) }
+}
+
+func (r *Reloader) unsubscribe(client chan struct{}) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	delete(r.clients, client)
+}
+
+func (r *Reloader) Reload() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, callback := range r.callbacks {
+		callback()
+	}
+
+	for client := range r.clients {
+		select {
+		case client <- struct{}{}:
+		default:
+		}
+	}
+}
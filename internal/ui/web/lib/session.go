@@ -0,0 +1,51 @@
+package lib
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/geisonbiazus/blog/internal/core/auth"
+)
+
+const SessionCookieName = "_blog_session"
+
+type currentUserKey struct{}
+
+// NewSessionCookie keeps the token away from scripts and out of the requests
+// other sites make, other than following links.
+func NewSessionCookie(token string) *http.Cookie {
+	return &http.Cookie{
+		Name:     SessionCookieName,
+		Value:    token,
+		Path:     "/",
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	}
+}
+
+func NewExpiredSessionCookie() *http.Cookie {
+	return &http.Cookie{
+		Name:     SessionCookieName,
+		Value:    "",
+		Path:     "/",
+		MaxAge:   -1,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	}
+}
+
+func WithCurrentUser(ctx context.Context, user auth.User) context.Context {
+	return context.WithValue(ctx, currentUserKey{}, user)
+}
+
+func CurrentUser(ctx context.Context) (auth.User, bool) {
+	user, ok := ctx.Value(currentUserKey{}).(auth.User)
+	return user, ok
+}
+
+// CurrentUserCan tells whether there is a current user and their role grants
+// the given permission.
+func CurrentUserCan(ctx context.Context, permission auth.Permission) bool {
+	user, ok := CurrentUser(ctx)
+	return ok && user.Can(permission)
+}
@@ -0,0 +1,141 @@
+package lib
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// StaticAssets fingerprints the URLs of the static files with a hash of their
+// content, e.g. /static/styles.css becomes /static/styles.1a2b3c4d5e.css, so
+// they can be cached for long and still be fetched again once they change.
+type StaticAssets struct {
+	urlPrefix string
+	basePath  string
+	hashes    map[string]string
+	version   string
+	mutex     sync.Mutex
+}
+
+func NewStaticAssets(urlPrefix, basePath string) *StaticAssets {
+	return &StaticAssets{
+		urlPrefix: urlPrefix,
+		basePath:  basePath,
+		hashes:    map[string]string{},
+	}
+}
+
+const fingerprintLength = 10
+
+var reFingerprint = regexp.MustCompile(`^(.+)\.([0-9a-f]{10})(\.[^./]+)?$`)
+
+// Path returns the fingerprinted URL path of the file, given relative to the
+// static files directory. Files that can't be read are left unfingerprinted.
+func (a *StaticAssets) Path(filePath string) string {
+	hash := a.hash(filePath)
+
+	if hash == "" {
+		return a.urlPrefix + filePath
+	}
+
+	ext := path.Ext(filePath)
+
+	return a.urlPrefix + filePath[:len(filePath)-len(ext)] + "." + hash + ext
+}
+
+// Resolve returns the file a path relative to the URL prefix points to. The
+// path is immutable when it's fingerprinted with the current content of the
+// file, otherwise it's either not fingerprinted or an outdated URL.
+func (a *StaticAssets) Resolve(urlPath string) (filePath string, immutable bool) {
+	match := reFingerprint.FindStringSubmatch(urlPath)
+
+	if match == nil {
+		return urlPath, false
+	}
+
+	filePath = match[1] + match[3]
+	hash := a.hash(filePath)
+
+	if hash == "" {
+		return urlPath, false
+	}
+
+	return filePath, hash == match[2]
+}
+
+// ClearCache discards the hashes so they are computed from the files again.
+func (a *StaticAssets) ClearCache() {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.hashes = map[string]string{}
+	a.version = ""
+}
+
+// Version changes whenever any of the static files change.
+func (a *StaticAssets) Version() string {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if a.version == "" {
+		a.version = a.computeVersion()
+	}
+
+	return a.version
+}
+
+func (a *StaticAssets) computeVersion() string {
+	hash := sha256.New()
+
+	filepath.WalkDir(a.basePath, func(filePath string, entry fs.DirEntry, err error) error {
+		if err == nil && !entry.IsDir() {
+			io.WriteString(hash, filePath)
+			io.WriteString(hash, a.hashFile(strings.TrimPrefix(filePath, a.basePath)))
+		}
+
+		return nil
+	})
+
+	return hex.EncodeToString(hash.Sum(nil))[:fingerprintLength]
+}
+
+func (a *StaticAssets) hash(filePath string) string {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if hash, ok := a.hashes[filePath]; ok {
+		return hash
+	}
+
+	// Missing files aren't kept, as any path can be requested.
+	hash := a.hashFile(filePath)
+
+	if hash != "" {
+		a.hashes[filePath] = hash
+	}
+
+	return hash
+}
+
+func (a *StaticAssets) hashFile(filePath string) string {
+	file, err := os.Open(filepath.Join(a.basePath, filepath.FromSlash(path.Clean("/"+filePath))))
+	if err != nil {
+		return ""
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+
+	if _, err := io.Copy(hash, file); err != nil {
+		return ""
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))[:fingerprintLength]
+}
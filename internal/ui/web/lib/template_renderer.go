@@ -1,16 +1,28 @@
 package lib
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"html/template"
 	"io"
+	"net/http"
+	"os"
 	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/geisonbiazus/blog/internal/core/auth"
 )
 
 type TemplateRenderer struct {
 	basePath        string
 	baseURL         string
 	cachedTemplates map[string]*template.Template
+	staticAssets    *StaticAssets
+	version         string
+	liveReload      bool
+	mutex           sync.Mutex
 }
 
 func NewTemplateRenderer(basePath, baseURL string) *TemplateRenderer {
@@ -21,12 +33,67 @@ func NewTemplateRenderer(basePath, baseURL string) *TemplateRenderer {
 	}
 }
 
-func (r *TemplateRenderer) Render(writer io.Writer, templateName string, data interface{}) {
+// EnableLiveReload makes the layout listen to the reload events so the page is
+// refreshed when the content changes.
+func (r *TemplateRenderer) EnableLiveReload() {
+	r.liveReload = true
+}
+
+// UseStaticAssets makes the staticPath function of the templates return the
+// fingerprinted URLs of the static files.
+func (r *TemplateRenderer) UseStaticAssets(staticAssets *StaticAssets) {
+	r.staticAssets = staticAssets
+}
+
+// ClearCache discards the parsed templates so they are read from disk again.
+func (r *TemplateRenderer) ClearCache() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.cachedTemplates = map[string]*template.Template{}
+	r.version = ""
+}
+
+// Version changes whenever the templates change, as do the URLs of the static
+// files they reference, so it takes part in the validators of rendered pages.
+func (r *TemplateRenderer) Version() string {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.version == "" {
+		r.version = r.computeVersion()
+	}
+
+	return r.version
+}
+
+func (r *TemplateRenderer) computeVersion() string {
+	hash := sha256.New()
+	files, _ := filepath.Glob(filepath.Join(r.basePath, "*.html"))
+	sort.Strings(files)
+
+	for _, file := range files {
+		content, _ := os.ReadFile(file)
+		hash.Write(content)
+	}
+
+	if r.staticAssets != nil {
+		io.WriteString(hash, r.staticAssets.Version())
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))[:16]
+}
+
+func (r *TemplateRenderer) Render(writer io.Writer, req *http.Request, templateName string, data interface{}) {
 	tmpl := r.resolveTemplate(templateName)
+	tmpl = template.Must(tmpl.Clone()).Funcs(r.requestFuncs(req))
 	tmpl.Execute(writer, data)
 }
 
 func (r *TemplateRenderer) resolveTemplate(name string) *template.Template {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
 	tmpl, ok := r.cachedTemplates[name]
 
 	if !ok {
@@ -52,10 +119,39 @@ func (r *TemplateRenderer) parseTemplate(name string) *template.Template {
 
 func (r *TemplateRenderer) templateFuncs() template.FuncMap {
 	return template.FuncMap{
-		"urlFor": r.urlFor,
+		"urlFor":      r.urlFor,
+		"staticPath":  r.staticPath,
+		"currentUser": func() *auth.User { return nil },
+		"csrfToken":   func() string { return "" },
+		"liveReload":  func() bool { return r.liveReload },
 	}
 }
 
+func (r *TemplateRenderer) requestFuncs(req *http.Request) template.FuncMap {
+	return template.FuncMap{
+		"currentUser": func() *auth.User { return r.currentUser(req) },
+		"csrfToken":   func() string { return CSRFToken(req) },
+	}
+}
+
+func (r *TemplateRenderer) currentUser(req *http.Request) *auth.User {
+	user, ok := CurrentUser(req.Context())
+
+	if !ok {
+		return nil
+	}
+
+	return &user
+}
+
+func (r *TemplateRenderer) staticPath(path string) string {
+	if r.staticAssets == nil {
+		return "/static" + path
+	}
+
+	return r.staticAssets.Path(path)
+}
+
 func (r *TemplateRenderer) urlFor(path string) string {
 	return fmt.Sprintf("%s%s", r.baseURL, path)
 }
@@ -3,16 +3,35 @@ package ports
 import (
 	"context"
 
+	"github.com/geisonbiazus/blog/internal/core/auth"
 	"github.com/geisonbiazus/blog/internal/core/blog"
 	"github.com/geisonbiazus/blog/internal/core/discussion"
 )
 
 type UseCases struct {
-	ViewPost      ViewPostUseCase
-	ListPosts     ListPostUseCase
-	RequestOAuth2 RequestOAuth2UseCase
-	ConfirmOAuth2 ConfirmOAuth2UseCase
-	ListComments  ListCommentsUseCase
+	ViewPost            ViewPostUseCase
+	ListPosts           ListPostUseCase
+	ListRenderedPosts   ListRenderedPostsUseCase
+	ListPostsByTag      ListPostsByTagUseCase
+	ListPostsByAuthor   ListPostsByAuthorUseCase
+	ListTags            ListTagsUseCase
+	ViewSeries          ViewSeriesUseCase
+	SearchPosts         SearchPostsUseCase
+	RelatedPosts        RelatedPostsUseCase
+	PreviewPost         PreviewPostUseCase
+	ListPostPreviews    ListPostPreviewsUseCase
+	RequestOAuth2       RequestOAuth2UseCase
+	ConfirmOAuth2       ConfirmOAuth2UseCase
+	AuthenticateToken   AuthenticateTokenUseCase
+	Logout              LogoutUseCase
+	ListComments        ListCommentsUseCase
+	ListRecentComments  ListRecentCommentsUseCase
+	CreateComment       CreateCommentUseCase
+	EditComment         EditCommentUseCase
+	DeleteComment       DeleteCommentUseCase
+	ListPendingComments ListPendingCommentsUseCase
+	ModerateComment     ModerateCommentUseCase
+	ChangeUserRole      ChangeUserRoleUseCase
 }
 
 type ViewPostUseCase interface {
@@ -20,9 +39,45 @@ type ViewPostUseCase interface {
 }
 
 type ListPostUseCase interface {
+	Run(page, limit int) (blog.PostPage, error)
+}
+
+type ListRenderedPostsUseCase interface {
 	Run() ([]blog.RenderedPost, error)
 }
 
+type ListPostsByTagUseCase interface {
+	Run(tag string) ([]blog.Post, error)
+}
+
+type ListPostsByAuthorUseCase interface {
+	Run(authorSlug string) ([]blog.Post, error)
+}
+
+type ListTagsUseCase interface {
+	Run() ([]blog.TagCount, error)
+}
+
+type ViewSeriesUseCase interface {
+	Run(slug string) (blog.Series, error)
+}
+
+type SearchPostsUseCase interface {
+	Run(query string) ([]blog.SearchResult, error)
+}
+
+type RelatedPostsUseCase interface {
+	Run(path string, limit int) ([]blog.Post, error)
+}
+
+type PreviewPostUseCase interface {
+	Run(path, signature string) (blog.RenderedPost, error)
+}
+
+type ListPostPreviewsUseCase interface {
+	Run() ([]blog.PostPreview, error)
+}
+
 type RequestOAuth2UseCase interface {
 	Run() (string, error)
 }
@@ -31,6 +86,42 @@ type ConfirmOAuth2UseCase interface {
 	Run(ctx context.Context, state, code string) (string, error)
 }
 
+type AuthenticateTokenUseCase interface {
+	Run(ctx context.Context, token string) (auth.User, error)
+}
+
+type LogoutUseCase interface {
+	Run(ctx context.Context, token string) error
+}
+
 type ListCommentsUseCase interface {
-	Run(ctx context.Context, subjectID string) ([]*discussion.Comment, error)
+	Run(ctx context.Context, subjectID, viewerUserID string) ([]*discussion.Comment, error)
+}
+
+type ListRecentCommentsUseCase interface {
+	Run(ctx context.Context, subjectID string, limit int) ([]*discussion.Comment, error)
+}
+
+type CreateCommentUseCase interface {
+	Run(ctx context.Context, input discussion.CreateCommentInput) (*discussion.Comment, error)
+}
+
+type EditCommentUseCase interface {
+	Run(ctx context.Context, input discussion.EditCommentInput) (*discussion.Comment, error)
+}
+
+type DeleteCommentUseCase interface {
+	Run(ctx context.Context, userID, commentID string) error
+}
+
+type ListPendingCommentsUseCase interface {
+	Run(ctx context.Context, moderatorUserID string) ([]*discussion.Comment, error)
+}
+
+type ModerateCommentUseCase interface {
+	Run(ctx context.Context, moderatorUserID, commentID string, status discussion.CommentStatus) error
+}
+
+type ChangeUserRoleUseCase interface {
+	Run(ctx context.Context, actor auth.User, userID string, role auth.Role) error
 }
@@ -8,18 +8,76 @@ import (
 	"github.com/geisonbiazus/blog/internal/ui/web/ports"
 )
 
-func NewRouter(templatePath, staticFilesPath string, usecases *ports.UseCases, baseURL string) http.Handler {
+// NewRouter builds the application routes. Crawlers are asked to skip the
+// robotsDisallow paths. When a reloader is given, the templates are read again
+// on every content change. With liveReload the open pages are reloaded too,
+// which is only meant for development as it serves /_reload to everyone.
+func NewRouter(
+	templatePath, staticFilesPath string,
+	usecases *ports.UseCases,
+	baseURL string,
+	robotsDisallow []string,
+	feedConfig handlers.FeedConfig,
+	reloader *lib.Reloader,
+	liveReload bool,
+) http.Handler {
+	staticAssets := lib.NewStaticAssets("/static", staticFilesPath)
 	templateRenderer := lib.NewTemplateRenderer(templatePath, baseURL)
+	templateRenderer.UseStaticAssets(staticAssets)
 
 	mux := http.NewServeMux()
 
-	mux.Handle("/static/", http.StripPrefix("/static", http.FileServer(http.Dir(staticFilesPath))))
-	mux.Handle("/", handlers.NewListPostsHandler(usecases.ListPosts, templateRenderer))
-	mux.Handle("/posts/", handlers.NewViewPostHandler(usecases.ViewPost, usecases.ListComments, templateRenderer))
-	mux.Handle("/feed.atom", handlers.NewFeedHandler(usecases.ListPosts, templateRenderer, baseURL))
+	if reloader != nil {
+		reloader.OnReload(staticAssets.ClearCache)
+		reloader.OnReload(templateRenderer.ClearCache)
+	}
+
+	if reloader != nil && liveReload {
+		templateRenderer.EnableLiveReload()
+		mux.Handle("/_reload", handlers.NewReloadHandler(reloader))
+	}
+
+	mux.Handle("/static/", http.StripPrefix("/static", handlers.NewStaticHandler(staticFilesPath, staticAssets)))
+	mux.Handle("/", handlers.NewListPostsHandler(usecases.ListPosts, usecases.ListTags, templateRenderer))
+	feedHandler := handlers.NewFeedHandler(usecases.ListRenderedPosts, templateRenderer, baseURL, feedConfig)
+	commentsFeedHandler := handlers.NewCommentsFeedHandler(usecases.ListRecentComments, usecases.ViewPost, templateRenderer, baseURL, feedConfig)
+
+	mux.Handle("/tags/", withFeeds(handlers.IsFeedPath, feedHandler, handlers.NewListPostsByTagHandler(usecases.ListPostsByTag, templateRenderer)))
+	mux.Handle("/search", handlers.NewSearchPostsHandler(usecases.SearchPosts, templateRenderer))
+	mux.Handle("/series/", withFeeds(handlers.IsFeedPath, feedHandler, handlers.NewViewSeriesHandler(usecases.ViewSeries, templateRenderer)))
+	mux.Handle("/authors/", withFeeds(handlers.IsFeedPath, feedHandler, handlers.NewListPostsByAuthorHandler(usecases.ListPostsByAuthor, templateRenderer)))
+	mux.Handle("/posts/", withFeeds(handlers.IsCommentsFeedPath, commentsFeedHandler, handlers.NewViewPostHandler(usecases.ViewPost, usecases.ViewSeries, usecases.RelatedPosts, usecases.ListComments, templateRenderer)))
+	mux.Handle("/preview/", handlers.NewPreviewPostHandler(usecases.PreviewPost, templateRenderer))
+	mux.Handle("/comments", handlers.NewCreateCommentHandler(usecases.CreateComment, templateRenderer))
+	mux.Handle("/comments/edit", handlers.NewEditCommentHandler(usecases.EditComment, templateRenderer))
+	mux.Handle("/comments/delete", handlers.NewDeleteCommentHandler(usecases.DeleteComment, templateRenderer))
+	mux.Handle("/admin/comments", handlers.NewAdminCommentsHandler(usecases.ListPendingComments, templateRenderer))
+	mux.Handle("/admin/comments/moderate", handlers.NewModerateCommentHandler(usecases.ModerateComment, templateRenderer))
+	mux.Handle("/admin/drafts", handlers.NewAdminDraftsHandler(usecases.ListPostPreviews, templateRenderer))
+	mux.Handle("/admin/users/role", handlers.NewChangeUserRoleHandler(usecases.ChangeUserRole, templateRenderer))
+	mux.Handle("/feed.atom", feedHandler)
+	mux.Handle("/feed.rss", feedHandler)
+	mux.Handle("/feed.json", feedHandler)
+	mux.Handle("/comments.atom", commentsFeedHandler)
+	mux.Handle("/sitemap.xml", handlers.NewSitemapHandler(usecases.ListPosts, templateRenderer, baseURL))
+	mux.Handle("/robots.txt", handlers.NewRobotsHandler(baseURL, robotsDisallow))
 	mux.Handle("/about", handlers.NewTemplateHandler(templateRenderer, "about.html"))
 	mux.Handle("/login/github", handlers.NewRequestOAuth2Handler(usecases.RequestOAuth2, templateRenderer))
 	mux.Handle("/login/github/confirm", handlers.NewConfirmOAuth2Handler(usecases.ConfirmOAuth2, templateRenderer, baseURL))
+	mux.Handle("/logout", handlers.NewLogoutHandler(usecases.Logout, templateRenderer))
+
+	return handlers.NewSessionHandler(usecases.AuthenticateToken, mux)
+}
+
+// withFeeds serves the feeds scoped to a page, e.g. /tags/go/feed.atom, along
+// with the page itself.
+func withFeeds(isFeedPath func(string) bool, feedHandler, pageHandler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if isFeedPath(r.URL.Path) {
+			feedHandler.ServeHTTP(w, r)
+			return
+		}
 
-	return mux
+		pageHandler.ServeHTTP(w, r)
+	})
 }
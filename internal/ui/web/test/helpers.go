@@ -3,7 +3,9 @@ package test
 import (
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"path/filepath"
+	"strings"
 
 	"github.com/geisonbiazus/blog/internal/ui/web/lib"
 )
@@ -18,6 +20,24 @@ func NewTestTemplateRenderer() *lib.TemplateRenderer {
 
 func DoGetRequest(handler http.Handler, path string) *http.Response {
 	req := httptest.NewRequest(http.MethodGet, path, nil)
+	return DoRequest(handler, req)
+}
+
+// DoConditionalGetRequest sends the validators of a previous response back, as
+// in If-None-Match or If-Modified-Since.
+func DoConditionalGetRequest(handler http.Handler, path, header, value string) *http.Response {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	req.Header.Set(header, value)
+	return DoRequest(handler, req)
+}
+
+func NewPostFormRequest(path string, form url.Values) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return req
+}
+
+func DoRequest(handler http.Handler, req *http.Request) *http.Response {
 	rw := httptest.NewRecorder()
 
 	handler.ServeHTTP(rw, req)
@@ -4,6 +4,7 @@ import (
 	"log"
 	"os"
 	"strconv"
+	"strings"
 )
 
 func GetString(name, defaultValue string) string {
@@ -31,3 +32,37 @@ func GetInt(name string, defaultValue int) int {
 
 	return n
 }
+
+func GetStrings(name string, defaultValue []string) []string {
+	value := os.Getenv(name)
+
+	if value == "" {
+		return defaultValue
+	}
+
+	result := []string{}
+
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+
+	return result
+}
+
+func GetBool(name string, defaultValue bool) bool {
+	value := os.Getenv(name)
+
+	if value == "" {
+		return defaultValue
+	}
+
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		log.Printf("WARNING: %v is not a bool. Using default value of %v", name, defaultValue)
+		return defaultValue
+	}
+
+	return b
+}
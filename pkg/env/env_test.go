@@ -37,3 +37,33 @@ func TestGetInt(t *testing.T) {
 		os.Unsetenv("TEST_VAR")
 	})
 }
+
+func TestGetStrings(t *testing.T) {
+	t.Run("It returns default value when variable is not present", func(t *testing.T) {
+		assert.Equal(t, []string{"default"}, env.GetStrings("TEST_VAR", []string{"default"}))
+	})
+
+	t.Run("It splits the ENV var value by commas ignoring blank items", func(t *testing.T) {
+		os.Setenv("TEST_VAR", "1, 2,,3 ")
+		assert.Equal(t, []string{"1", "2", "3"}, env.GetStrings("TEST_VAR", []string{}))
+		os.Unsetenv("TEST_VAR")
+	})
+}
+
+func TestGetBool(t *testing.T) {
+	t.Run("It returns default value when variable is not present", func(t *testing.T) {
+		assert.Equal(t, true, env.GetBool("TEST_VAR", true))
+	})
+
+	t.Run("It returns an ENV var value when variable is present", func(t *testing.T) {
+		os.Setenv("TEST_VAR", "true")
+		assert.Equal(t, true, env.GetBool("TEST_VAR", false))
+		os.Unsetenv("TEST_VAR")
+	})
+
+	t.Run("It returns default value when variable is present but not a bool", func(t *testing.T) {
+		os.Setenv("TEST_VAR", "asd")
+		assert.Equal(t, false, env.GetBool("TEST_VAR", false))
+		os.Unsetenv("TEST_VAR")
+	})
+}
@@ -0,0 +1,28 @@
+package content_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/geisonbiazus/blog/internal/adapters/postrepo"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestPosts fails the build when the published content has problems that
+// would keep the server from starting in strict mode.
+func TestPosts(t *testing.T) {
+	basePath := filepath.Join("..", "..")
+	staticPath := filepath.Join(basePath, "web", "static")
+
+	for _, postPath := range []string{filepath.Join(basePath, "posts"), filepath.Join(basePath, "test", "posts")} {
+		t.Run(postPath, func(t *testing.T) {
+			diagnostics, err := postrepo.NewFileSystemPostValidator(postPath, staticPath).Validate()
+
+			assert.Nil(t, err)
+
+			for _, diagnostic := range diagnostics {
+				t.Error(diagnostic)
+			}
+		})
+	}
+}
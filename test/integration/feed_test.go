@@ -24,4 +24,75 @@ func TestFeedIntegration(t *testing.T) {
 		assert.Contains(t, body, "<updated>2021-04-05T18:47:00Z</updated>")
 		assert.Contains(t, body, "Content")
 	})
+
+	t.Run("Returns the RSS and JSON feeds of the published posts", func(t *testing.T) {
+		server := newServer()
+		defer server.Close()
+
+		rss, _ := http.Get(server.URL + "/feed.rss")
+		json, _ := http.Get(server.URL + "/feed.json")
+
+		rssBody := testhelper.ReadResponseBody(rss)
+		jsonBody := testhelper.ReadResponseBody(json)
+
+		assert.Equal(t, http.StatusOK, rss.StatusCode)
+		assert.Equal(t, "application/rss+xml", rss.Header.Get("Content-Type"))
+		assert.Contains(t, rssBody, "<title>Test Post</title>")
+		assert.Equal(t, http.StatusOK, json.StatusCode)
+		assert.Equal(t, "application/feed+json", json.Header.Get("Content-Type"))
+		assert.Contains(t, jsonBody, `"title": "Test Post"`)
+		assert.NotContains(t, jsonBody, "Draft Post")
+	})
+}
+
+func TestConditionalFeedIntegration(t *testing.T) {
+	t.Run("Returns not modified when the feed didn't change", func(t *testing.T) {
+		server := newServer()
+		defer server.Close()
+
+		res, _ := http.Get(server.URL + "/feed.atom")
+
+		req, _ := http.NewRequest(http.MethodGet, server.URL+"/feed.atom", nil)
+		req.Header.Set("If-None-Match", res.Header.Get("ETag"))
+		res, _ = http.DefaultClient.Do(req)
+
+		assert.Equal(t, http.StatusNotModified, res.StatusCode)
+	})
+}
+
+func TestScopedFeedIntegration(t *testing.T) {
+	t.Run("Returns the feed of the posts with the tag", func(t *testing.T) {
+		server := newServer()
+		defer server.Close()
+
+		res, _ := http.Get(server.URL + "/tags/testing/feed.atom")
+
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, "application/atom+xml", res.Header.Get("Content-Type"))
+		assert.Contains(t, body, "<title>Geison Biazus - #testing</title>")
+		assert.Contains(t, body, "<title>Test Post</title>")
+		assert.Contains(t, body, `/tags/testing/feed.atom" rel="self">`)
+	})
+
+	t.Run("Returns not found for unknown tags", func(t *testing.T) {
+		server := newServer()
+		defer server.Close()
+
+		res, _ := http.Get(server.URL + "/tags/unknown/feed.atom")
+
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+	})
+}
+
+func TestCommentsFeedIntegration(t *testing.T) {
+	t.Run("Returns not found for the comments of unknown posts", func(t *testing.T) {
+		server := newServer()
+		defer server.Close()
+
+		res, _ := http.Get(server.URL + "/posts/unknown/comments.atom")
+
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+	})
 }
@@ -10,6 +10,12 @@ import (
 )
 
 func newServer() *httptest.Server {
+	return newServerWith(func(c *app.Context) {})
+}
+
+// newServerWith lets the test change the configuration before the routes are
+// built.
+func newServerWith(configure func(c *app.Context)) *httptest.Server {
 	os.Setenv("ENV", "test")
 	c := app.NewContext()
 
@@ -20,6 +26,7 @@ func newServer() *httptest.Server {
 	c.PostPath = filepath.Join(basePath, "test", "posts")
 	c.GitHubClientID = "github_client_id"
 	c.GitHubClientSecret = "github_client_secret"
+	configure(c)
 	return httptest.NewServer(c.Router())
 }
 
@@ -22,5 +22,15 @@ func TestListPostsIntegration(t *testing.T) {
 		assert.Contains(t, body, "/posts/test-post")
 		assert.Contains(t, body, "Geison Biazus")
 		assert.Contains(t, body, "April 5, 2021")
+		assert.NotContains(t, body, "Draft Post")
+	})
+
+	t.Run("Returns not found for pages after the last one", func(t *testing.T) {
+		server := newServer()
+		defer server.Close()
+
+		res, _ := http.Get(server.URL + "/?page=2")
+
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
 	})
 }
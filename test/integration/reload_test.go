@@ -0,0 +1,40 @@
+package integration_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/geisonbiazus/blog/internal/app"
+	"github.com/geisonbiazus/blog/pkg/testhelper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReloadIntegration(t *testing.T) {
+	t.Run("It enables the live reload in development", func(t *testing.T) {
+		server := newServerWith(func(c *app.Context) {
+			c.Env = "development"
+			c.HotReload = true
+			c.LiveReload = true
+		})
+		defer server.Close()
+
+		res, _ := http.Get(server.URL + "/about")
+
+		assert.Contains(t, testhelper.ReadResponseBody(res), "new EventSource('/_reload')")
+	})
+
+	t.Run("It never enables the live reload outside development", func(t *testing.T) {
+		server := newServerWith(func(c *app.Context) {
+			c.Env = "production"
+			c.HotReload = true
+			c.LiveReload = true
+		})
+		defer server.Close()
+
+		page, _ := http.Get(server.URL + "/about")
+		reload, _ := http.Get(server.URL + "/_reload")
+
+		assert.NotContains(t, testhelper.ReadResponseBody(page), "EventSource")
+		assert.NotEqual(t, "text/event-stream", reload.Header.Get("Content-Type"))
+	})
+}
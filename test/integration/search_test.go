@@ -0,0 +1,36 @@
+package integration_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/geisonbiazus/blog/pkg/testhelper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchIntegration(t *testing.T) {
+	t.Run("Returns the published posts matching the query", func(t *testing.T) {
+		server := newServer()
+		defer server.Close()
+
+		res, _ := http.Get(server.URL + "/search?q=content")
+
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Contains(t, body, "/posts/test-post")
+		assert.Contains(t, body, "<mark>Content</mark>")
+	})
+
+	t.Run("Doesn't return drafts", func(t *testing.T) {
+		server := newServer()
+		defer server.Close()
+
+		res, _ := http.Get(server.URL + "/search?q=ready")
+
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.NotContains(t, body, "/posts/draft-post")
+	})
+}
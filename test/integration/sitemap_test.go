@@ -0,0 +1,40 @@
+package integration_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/geisonbiazus/blog/pkg/testhelper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSitemapIntegration(t *testing.T) {
+	t.Run("Returns the sitemap of the published posts", func(t *testing.T) {
+		server := newServer()
+		defer server.Close()
+
+		res, _ := http.Get(server.URL + "/sitemap.xml")
+
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, "application/xml", res.Header.Get("Content-Type"))
+		assert.Contains(t, body, "<loc>http://localhost:3000/posts/test-post</loc>")
+		assert.Contains(t, body, "<loc>http://localhost:3000/tags/testing</loc>")
+		assert.Contains(t, body, "<loc>http://localhost:3000/about</loc>")
+		assert.NotContains(t, body, "draft-post")
+	})
+
+	t.Run("Returns the robots.txt pointing to the sitemap", func(t *testing.T) {
+		server := newServer()
+		defer server.Close()
+
+		res, _ := http.Get(server.URL + "/robots.txt")
+
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Contains(t, body, "Disallow: /admin/\n")
+		assert.Contains(t, body, "Sitemap: http://localhost:3000/sitemap.xml\n")
+	})
+}
@@ -2,6 +2,7 @@ package integration_test
 
 import (
 	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/geisonbiazus/blog/pkg/testhelper"
@@ -19,4 +20,18 @@ func TestStaticIntegration(t *testing.T) {
 		assert.Equal(t, http.StatusOK, res.StatusCode)
 		assert.Contains(t, body, ".blog-container")
 	})
+
+	t.Run("It links the pages to fingerprinted static files cached for good", func(t *testing.T) {
+		server := newServer()
+		defer server.Close()
+
+		page, _ := http.Get(server.URL + "/")
+		stylesPath := regexp.MustCompile(`/static/styles\.[0-9a-f]{10}\.css`).FindString(testhelper.ReadResponseBody(page))
+
+		res, _ := http.Get(server.URL + stylesPath)
+
+		assert.NotEmpty(t, stylesPath)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, "public, max-age=31536000, immutable", res.Header.Get("Cache-Control"))
+	})
 }
@@ -0,0 +1,33 @@
+package integration_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/geisonbiazus/blog/pkg/testhelper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTagsIntegration(t *testing.T) {
+	t.Run("Returns a list of the posts with the given tag", func(t *testing.T) {
+		server := newServer()
+		defer server.Close()
+
+		res, _ := http.Get(server.URL + "/tags/testing")
+
+		body := testhelper.ReadResponseBody(res)
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Contains(t, body, "Posts tagged #testing")
+		assert.Contains(t, body, "/posts/test-post")
+	})
+
+	t.Run("Returns not found for unknown tags", func(t *testing.T) {
+		server := newServer()
+		defer server.Close()
+
+		res, _ := http.Get(server.URL + "/tags/unknown")
+
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+	})
+}
@@ -23,4 +23,13 @@ func TestViewPostIntegration(t *testing.T) {
 		assert.Contains(t, body, "April 5, 2021")
 		assert.Contains(t, body, "Content")
 	})
+
+	t.Run("Given a draft post path it responds with not found", func(t *testing.T) {
+		server := newServer()
+		defer server.Close()
+
+		res, _ := http.Get(server.URL + "/posts/draft-post")
+
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+	})
 }